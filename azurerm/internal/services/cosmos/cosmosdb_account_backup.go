@@ -0,0 +1,130 @@
+package cosmos
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// schemaCosmosDbAccountBackup returns the `backup` block for `azurerm_cosmosdb_account` - it needs
+// to be set to `Continuous` for an account to be a valid `restore.source_cosmosdb_account_id` target
+// for `azurerm_cosmosdb_sql_database`'s `restore` block.
+//
+// NOTE: the `azurerm_cosmosdb_account` resource itself is not part of this checkout, so this block
+// is not yet wired into a `Schema`/`Create`/`Read` - do that as part of whatever change brings the
+// account resource into this tree.
+func schemaCosmosDbAccountBackup() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		Computed: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"type": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Default:  string(documentdb.TypePeriodic),
+					ValidateFunc: validation.StringInSlice([]string{
+						string(documentdb.TypeContinuous),
+						string(documentdb.TypePeriodic),
+					}, false),
+				},
+
+				"interval_in_minutes": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(60, 1440),
+				},
+
+				"retention_in_hours": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validation.IntBetween(8, 720),
+				},
+
+				"storage_redundancy": {
+					Type:     schema.TypeString,
+					Optional: true,
+					Computed: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						string(documentdb.BackupStorageRedundancyGeo),
+						string(documentdb.BackupStorageRedundancyLocal),
+						string(documentdb.BackupStorageRedundancyZone),
+					}, false),
+				},
+			},
+		},
+	}
+}
+
+func expandCosmosDbAccountBackup(input []interface{}) documentdb.BasicBackupPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return documentdb.PeriodicModeBackupPolicy{
+			Type: documentdb.TypePeriodic,
+		}
+	}
+
+	v := input[0].(map[string]interface{})
+
+	if v["type"].(string) == string(documentdb.TypeContinuous) {
+		return documentdb.ContinuousModeBackupPolicy{
+			Type: documentdb.TypeContinuous,
+		}
+	}
+
+	policy := documentdb.PeriodicModeBackupPolicy{
+		Type: documentdb.TypePeriodic,
+		PeriodicModeProperties: &documentdb.PeriodicModeProperties{
+			BackupIntervalInMinutes:        utils.Int32(int32(v["interval_in_minutes"].(int))),
+			BackupRetentionIntervalInHours: utils.Int32(int32(v["retention_in_hours"].(int))),
+		},
+	}
+
+	if redundancy := v["storage_redundancy"].(string); redundancy != "" {
+		policy.PeriodicModeProperties.BackupStorageRedundancy = documentdb.BackupStorageRedundancy(redundancy)
+	}
+
+	return policy
+}
+
+func flattenCosmosDbAccountBackup(input documentdb.BasicBackupPolicy) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	if continuous, ok := input.AsContinuousModeBackupPolicy(); ok {
+		return []interface{}{
+			map[string]interface{}{
+				"type": string(continuous.Type),
+			},
+		}
+	}
+
+	periodic, ok := input.AsPeriodicModeBackupPolicy()
+	if !ok || periodic.PeriodicModeProperties == nil {
+		return []interface{}{}
+	}
+
+	intervalInMinutes := 0
+	if v := periodic.PeriodicModeProperties.BackupIntervalInMinutes; v != nil {
+		intervalInMinutes = int(*v)
+	}
+
+	retentionInHours := 0
+	if v := periodic.PeriodicModeProperties.BackupRetentionIntervalInHours; v != nil {
+		retentionInHours = int(*v)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"type":                string(periodic.Type),
+			"interval_in_minutes": intervalInMinutes,
+			"retention_in_hours":  retentionInHours,
+			"storage_redundancy":  string(periodic.PeriodicModeProperties.BackupStorageRedundancy),
+		},
+	}
+}
@@ -0,0 +1,123 @@
+package cosmos
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmCosmosDbMongoRoleDefinition() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmCosmosDbMongoRoleDefinitionRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.CosmosAccountName,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.CosmosEntityName,
+			},
+
+			"role_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"privilege": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"db":         {Type: schema.TypeString, Computed: true},
+									"collection": {Type: schema.TypeString, Computed: true},
+								},
+							},
+						},
+
+						"actions": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+
+			"roles_inherited": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db":   {Type: schema.TypeString, Computed: true},
+						"role": {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmCosmosDbMongoRoleDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.MongoDbRoleDefinitionClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	account := d.Get("account_name").(string)
+	database := d.Get("database_name").(string)
+	roleName := d.Get("role_name").(string)
+
+	id := parse.NewMongoRoleDefinitionID(subscriptionId, resourceGroup, account, database, roleName)
+
+	resp, err := client.GetMongoRoleDefinition(ctx, id.RoleDefinitionId(), resourceGroup, account)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Cosmos Mongo Role Definition %q (Account: %q, Database: %q) was not found", roleName, account, database)
+		}
+
+		return fmt.Errorf("reading Cosmos Mongo Role Definition %q (Account: %q, Database: %q): %+v", roleName, account, database, err)
+	}
+
+	d.SetId(id.ID())
+
+	if props := resp.MongoRoleDefinitionGetProperties; props != nil {
+		if err := d.Set("privilege", flattenCosmosDbMongoRoleDefinitionPrivileges(props.Privileges)); err != nil {
+			return fmt.Errorf("setting %q: %+v", "privilege", err)
+		}
+
+		if err := d.Set("roles_inherited", flattenCosmosDbMongoRoleDefinitionInheritedRoles(props.Roles)); err != nil {
+			return fmt.Errorf("setting %q: %+v", "roles_inherited", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,376 @@
+package cosmos
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCosmosDbMongoRoleDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCosmosDbMongoRoleDefinitionCreateUpdate,
+		Read:   resourceArmCosmosDbMongoRoleDefinitionRead,
+		Update: resourceArmCosmosDbMongoRoleDefinitionCreateUpdate,
+		Delete: resourceArmCosmosDbMongoRoleDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosAccountName,
+			},
+
+			"resource_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosEntityName,
+			},
+
+			"role_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"privilege": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"db": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+
+									"collection": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
+								},
+							},
+						},
+
+						"actions": {
+							Type:     schema.TypeList,
+							Required: true,
+							MinItems: 1,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+					},
+				},
+			},
+
+			"roles_inherited": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"role": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmCosmosDbMongoRoleDefinitionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.MongoDbRoleDefinitionClient
+	accountClient := meta.(*clients.Client).Cosmos.DatabaseClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	account := d.Get("account_name").(string)
+	database := d.Get("database_name").(string)
+	roleName := d.Get("role_name").(string)
+
+	id := parse.NewMongoRoleDefinitionID(subscriptionId, resourceGroup, account, database, roleName)
+
+	if d.IsNewResource() {
+		acc, err := accountClient.Get(ctx, resourceGroup, account)
+		if err != nil {
+			return fmt.Errorf("checking Cosmos Account %q (Resource Group %q) for Mongo RBAC support: %+v", account, resourceGroup, err)
+		}
+
+		if !cosmosAccountHasCapability(acc, "EnableMongoRoleBasedAccessControl") {
+			return fmt.Errorf("Cosmos Account %q (Resource Group %q) does not have the `EnableMongoRoleBasedAccessControl` capability enabled - Mongo RBAC resources cannot be created", account, resourceGroup)
+		}
+
+		existing, err := client.GetMongoRoleDefinition(ctx, id.RoleDefinitionId(), resourceGroup, account)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Cosmos Mongo Role Definition %q (Account: %q, Database: %q): %+v", roleName, account, database, err)
+			}
+		} else {
+			if existing.ID != nil && *existing.ID != "" {
+				return tf.ImportAsExistsError("azurerm_cosmosdb_mongo_role_definition", *existing.ID)
+			}
+		}
+	}
+
+	parameters := documentdb.MongoRoleDefinitionCreateUpdateParameters{
+		MongoRoleDefinitionResource: &documentdb.MongoRoleDefinitionResource{
+			RoleName:     utils.String(roleName),
+			DatabaseName: utils.String(database),
+			Privileges:   expandCosmosDbMongoRoleDefinitionPrivileges(d.Get("privilege").([]interface{})),
+			Roles:        expandCosmosDbMongoRoleDefinitionInheritedRoles(d.Get("roles_inherited").([]interface{})),
+		},
+	}
+
+	future, err := client.CreateUpdateMongoRoleDefinition(ctx, id.RoleDefinitionId(), resourceGroup, account, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating Cosmos Mongo Role Definition %q (Account: %q, Database: %q): %+v", roleName, account, database, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on create/update future for Cosmos Mongo Role Definition %q (Account: %q, Database: %q): %+v", roleName, account, database, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceArmCosmosDbMongoRoleDefinitionRead(d, meta)
+}
+
+func resourceArmCosmosDbMongoRoleDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.MongoDbRoleDefinitionClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.MongoRoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetMongoRoleDefinition(ctx, id.RoleDefinitionId(), id.ResourceGroup, id.Account)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Error reading Cosmos Mongo Role Definition %q - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("reading Cosmos Mongo Role Definition %q (Account: %q, Database: %q): %+v", id.RoleName, id.Account, id.Database, err)
+	}
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.Account)
+
+	if props := resp.MongoRoleDefinitionGetProperties; props != nil {
+		d.Set("database_name", props.DatabaseName)
+		d.Set("role_name", props.RoleName)
+
+		if err := d.Set("privilege", flattenCosmosDbMongoRoleDefinitionPrivileges(props.Privileges)); err != nil {
+			return fmt.Errorf("setting %q: %+v", "privilege", err)
+		}
+
+		if err := d.Set("roles_inherited", flattenCosmosDbMongoRoleDefinitionInheritedRoles(props.Roles)); err != nil {
+			return fmt.Errorf("setting %q: %+v", "roles_inherited", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmCosmosDbMongoRoleDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.MongoDbRoleDefinitionClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.MongoRoleDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeleteMongoRoleDefinition(ctx, id.RoleDefinitionId(), id.ResourceGroup, id.Account)
+	if err != nil {
+		return fmt.Errorf("deleting Cosmos Mongo Role Definition %q (Account: %q, Database: %q): %+v", id.RoleName, id.Account, id.Database, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on delete future for Cosmos Mongo Role Definition %q (Account: %q, Database: %q): %+v", id.RoleName, id.Account, id.Database, err)
+	}
+
+	return nil
+}
+
+func expandCosmosDbMongoRoleDefinitionPrivileges(input []interface{}) *[]documentdb.Privilege {
+	out := make([]documentdb.Privilege, 0, len(input))
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		var res *documentdb.SecurityResource
+		if resRaw := v["resource"].([]interface{}); len(resRaw) > 0 && resRaw[0] != nil {
+			r := resRaw[0].(map[string]interface{})
+			res = &documentdb.SecurityResource{
+				Db:         utils.String(r["db"].(string)),
+				Collection: utils.String(r["collection"].(string)),
+			}
+		}
+
+		actionsRaw := v["actions"].([]interface{})
+		actions := make([]string, 0, len(actionsRaw))
+		for _, a := range actionsRaw {
+			actions = append(actions, a.(string))
+		}
+
+		out = append(out, documentdb.Privilege{
+			Resource: res,
+			Actions:  &actions,
+		})
+	}
+
+	return &out
+}
+
+func flattenCosmosDbMongoRoleDefinitionPrivileges(input *[]documentdb.Privilege) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	out := make([]interface{}, 0, len(*input))
+	for _, p := range *input {
+		resource := []interface{}{}
+		if p.Resource != nil {
+			db := ""
+			if p.Resource.Db != nil {
+				db = *p.Resource.Db
+			}
+
+			collection := ""
+			if p.Resource.Collection != nil {
+				collection = *p.Resource.Collection
+			}
+
+			resource = []interface{}{
+				map[string]interface{}{
+					"db":         db,
+					"collection": collection,
+				},
+			}
+		}
+
+		actions := []interface{}{}
+		if p.Actions != nil {
+			for _, a := range *p.Actions {
+				actions = append(actions, a)
+			}
+		}
+
+		out = append(out, map[string]interface{}{
+			"resource": resource,
+			"actions":  actions,
+		})
+	}
+
+	return out
+}
+
+func expandCosmosDbMongoRoleDefinitionInheritedRoles(input []interface{}) *[]documentdb.Role {
+	out := make([]documentdb.Role, 0, len(input))
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		out = append(out, documentdb.Role{
+			Db:   utils.String(v["db"].(string)),
+			Role: utils.String(v["role"].(string)),
+		})
+	}
+
+	return &out
+}
+
+func flattenCosmosDbMongoRoleDefinitionInheritedRoles(input *[]documentdb.Role) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	out := make([]interface{}, 0, len(*input))
+	for _, r := range *input {
+		db := ""
+		if r.Db != nil {
+			db = *r.Db
+		}
+
+		role := ""
+		if r.Role != nil {
+			role = *r.Role
+		}
+
+		out = append(out, map[string]interface{}{
+			"db":   db,
+			"role": role,
+		})
+	}
+
+	return out
+}
+
+// cosmosAccountHasCapability reports whether the given Cosmos DB account exposes the named capability.
+func cosmosAccountHasCapability(account documentdb.DatabaseAccountGetResults, name string) bool {
+	if account.DatabaseAccountGetProperties == nil || account.DatabaseAccountGetProperties.Capabilities == nil {
+		return false
+	}
+
+	for _, c := range *account.DatabaseAccountGetProperties.Capabilities {
+		if c.Name != nil && *c.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,111 @@
+package cosmos
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmCosmosDbMongoUserDefinition() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmCosmosDbMongoUserDefinitionRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.CosmosAccountName,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.CosmosEntityName,
+			},
+
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"custom_data": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"mechanisms": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"roles": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db":   {Type: schema.TypeString, Computed: true},
+						"role": {Type: schema.TypeString, Computed: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmCosmosDbMongoUserDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.MongoDbUserDefinitionClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	account := d.Get("account_name").(string)
+	database := d.Get("database_name").(string)
+	username := d.Get("username").(string)
+
+	id := parse.NewMongoUserDefinitionID(subscriptionId, resourceGroup, account, database, username)
+
+	resp, err := client.GetMongoUserDefinition(ctx, id.UserDefinitionId(), resourceGroup, account)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Cosmos Mongo User Definition %q (Account: %q, Database: %q) was not found", username, account, database)
+		}
+
+		return fmt.Errorf("reading Cosmos Mongo User Definition %q (Account: %q, Database: %q): %+v", username, account, database, err)
+	}
+
+	d.SetId(id.ID())
+
+	if props := resp.MongoUserDefinitionGetProperties; props != nil {
+		d.Set("custom_data", props.CustomData)
+
+		if props.Mechanisms != nil {
+			d.Set("mechanisms", flattenCosmosDbMongoUserDefinitionMechanisms(*props.Mechanisms))
+		}
+
+		if err := d.Set("roles", flattenCosmosDbMongoUserDefinitionRoles(props.Roles)); err != nil {
+			return fmt.Errorf("setting %q: %+v", "roles", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,304 @@
+package cosmos
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCosmosDbMongoUserDefinition() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCosmosDbMongoUserDefinitionCreateUpdate,
+		Read:   resourceArmCosmosDbMongoUserDefinitionRead,
+		Update: resourceArmCosmosDbMongoUserDefinitionCreateUpdate,
+		Delete: resourceArmCosmosDbMongoUserDefinitionDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosAccountName,
+			},
+
+			"resource_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosEntityName,
+			},
+
+			"username": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"password": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"custom_data": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+
+			"mechanisms": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+
+			"roles": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"db": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"role": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceArmCosmosDbMongoUserDefinitionCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.MongoDbUserDefinitionClient
+	accountClient := meta.(*clients.Client).Cosmos.DatabaseClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	account := d.Get("account_name").(string)
+	database := d.Get("database_name").(string)
+	username := d.Get("username").(string)
+
+	id := parse.NewMongoUserDefinitionID(subscriptionId, resourceGroup, account, database, username)
+
+	if d.IsNewResource() {
+		acc, err := accountClient.Get(ctx, resourceGroup, account)
+		if err != nil {
+			return fmt.Errorf("checking Cosmos Account %q (Resource Group %q) for Mongo RBAC support: %+v", account, resourceGroup, err)
+		}
+
+		if !cosmosAccountHasCapability(acc, "EnableMongoRoleBasedAccessControl") {
+			return fmt.Errorf("Cosmos Account %q (Resource Group %q) does not have the `EnableMongoRoleBasedAccessControl` capability enabled - Mongo RBAC resources cannot be created", account, resourceGroup)
+		}
+
+		existing, err := client.GetMongoUserDefinition(ctx, id.UserDefinitionId(), resourceGroup, account)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing Cosmos Mongo User Definition %q (Account: %q, Database: %q): %+v", username, account, database, err)
+			}
+		} else {
+			if existing.ID != nil && *existing.ID != "" {
+				return tf.ImportAsExistsError("azurerm_cosmosdb_mongo_user_definition", *existing.ID)
+			}
+		}
+	}
+
+	parameters := documentdb.MongoUserDefinitionCreateUpdateParameters{
+		MongoUserDefinitionResource: &documentdb.MongoUserDefinitionResource{
+			UserName:     utils.String(username),
+			Password:     utils.String(d.Get("password").(string)),
+			DatabaseName: utils.String(database),
+			CustomData:   utils.String(d.Get("custom_data").(string)),
+			Mechanisms:   utils.String(expandCosmosDbMongoUserDefinitionMechanisms(d.Get("mechanisms").([]interface{}))),
+			Roles:        expandCosmosDbMongoUserDefinitionRoles(d.Get("roles").([]interface{})),
+		},
+	}
+
+	future, err := client.CreateUpdateMongoUserDefinition(ctx, id.UserDefinitionId(), resourceGroup, account, parameters)
+	if err != nil {
+		return fmt.Errorf("creating/updating Cosmos Mongo User Definition %q (Account: %q, Database: %q): %+v", username, account, database, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on create/update future for Cosmos Mongo User Definition %q (Account: %q, Database: %q): %+v", username, account, database, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceArmCosmosDbMongoUserDefinitionRead(d, meta)
+}
+
+func resourceArmCosmosDbMongoUserDefinitionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.MongoDbUserDefinitionClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.MongoUserDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetMongoUserDefinition(ctx, id.UserDefinitionId(), id.ResourceGroup, id.Account)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Error reading Cosmos Mongo User Definition %q - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("reading Cosmos Mongo User Definition %q (Account: %q, Database: %q): %+v", id.Username, id.Account, id.Database, err)
+	}
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.Account)
+
+	if props := resp.MongoUserDefinitionGetProperties; props != nil {
+		d.Set("database_name", props.DatabaseName)
+		d.Set("username", props.UserName)
+		d.Set("custom_data", props.CustomData)
+
+		if props.Mechanisms != nil {
+			d.Set("mechanisms", flattenCosmosDbMongoUserDefinitionMechanisms(*props.Mechanisms))
+		}
+
+		if err := d.Set("roles", flattenCosmosDbMongoUserDefinitionRoles(props.Roles)); err != nil {
+			return fmt.Errorf("setting %q: %+v", "roles", err)
+		}
+	}
+
+	return nil
+}
+
+func resourceArmCosmosDbMongoUserDefinitionDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.MongoDbUserDefinitionClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.MongoUserDefinitionID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.DeleteMongoUserDefinition(ctx, id.UserDefinitionId(), id.ResourceGroup, id.Account)
+	if err != nil {
+		return fmt.Errorf("deleting Cosmos Mongo User Definition %q (Account: %q, Database: %q): %+v", id.Username, id.Account, id.Database, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on delete future for Cosmos Mongo User Definition %q (Account: %q, Database: %q): %+v", id.Username, id.Account, id.Database, err)
+	}
+
+	return nil
+}
+
+func expandCosmosDbMongoUserDefinitionMechanisms(input []interface{}) string {
+	out := make([]string, 0, len(input))
+	for _, v := range input {
+		out = append(out, v.(string))
+	}
+
+	mechanisms := ""
+	for i, m := range out {
+		if i > 0 {
+			mechanisms += ","
+		}
+		mechanisms += m
+	}
+
+	return mechanisms
+}
+
+func flattenCosmosDbMongoUserDefinitionMechanisms(input string) []interface{} {
+	if input == "" {
+		return []interface{}{}
+	}
+
+	out := []interface{}{}
+	start := 0
+	for i := 0; i <= len(input); i++ {
+		if i == len(input) || input[i] == ',' {
+			out = append(out, input[start:i])
+			start = i + 1
+		}
+	}
+
+	return out
+}
+
+func expandCosmosDbMongoUserDefinitionRoles(input []interface{}) *[]documentdb.Role {
+	out := make([]documentdb.Role, 0, len(input))
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+		out = append(out, documentdb.Role{
+			Db:   utils.String(v["db"].(string)),
+			Role: utils.String(v["role"].(string)),
+		})
+	}
+
+	return &out
+}
+
+func flattenCosmosDbMongoUserDefinitionRoles(input *[]documentdb.Role) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	out := make([]interface{}, 0, len(*input))
+	for _, r := range *input {
+		db := ""
+		if r.Db != nil {
+			db = *r.Db
+		}
+
+		role := ""
+		if r.Role != nil {
+			role = *r.Role
+		}
+
+		out = append(out, map[string]interface{}{
+			"db":   db,
+			"role": role,
+		})
+	}
+
+	return out
+}
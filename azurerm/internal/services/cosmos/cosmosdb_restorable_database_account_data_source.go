@@ -0,0 +1,129 @@
+package cosmos
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceArmCosmosDbRestorableDatabaseAccount() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmCosmosDbRestorableDatabaseAccountRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"location": azure.SchemaLocationForDataSource(),
+
+			"accounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"api_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"creation_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"deletion_time": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmCosmosDbRestorableDatabaseAccountRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.RestorableDatabaseAccountClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	location := d.Get("location").(string)
+
+	resp, err := client.ListByLocation(ctx, location)
+	if err != nil {
+		return fmt.Errorf("listing Restorable Database Accounts in %q: %+v", location, err)
+	}
+
+	accounts := make([]interface{}, 0)
+	values := resp.Values()
+	for _, account := range values {
+		if account.RestorableDatabaseAccountProperties == nil || account.RestorableDatabaseAccountProperties.AccountName == nil {
+			continue
+		}
+
+		if *account.RestorableDatabaseAccountProperties.AccountName != name {
+			continue
+		}
+
+		accounts = append(accounts, flattenCosmosDbRestorableDatabaseAccount(account))
+	}
+
+	if len(accounts) == 0 {
+		return fmt.Errorf("no Restorable Database Account found for %q in %q", name, location)
+	}
+
+	d.SetId(fmt.Sprintf("%s/restorableDatabaseAccounts/%s", location, name))
+	d.Set("accounts", accounts)
+
+	return nil
+}
+
+func flattenCosmosDbRestorableDatabaseAccount(input documentdb.RestorableDatabaseAccountGetResult) map[string]interface{} {
+	id := ""
+	if input.ID != nil {
+		id = *input.ID
+	}
+
+	apiType := ""
+	creationTime := ""
+	deletionTime := ""
+	if props := input.RestorableDatabaseAccountProperties; props != nil {
+		if props.APIType != "" {
+			apiType = string(props.APIType)
+		}
+
+		if props.CreationTime != nil {
+			creationTime = props.CreationTime.String()
+		}
+
+		if props.DeletionTime != nil {
+			deletionTime = props.DeletionTime.String()
+		}
+	}
+
+	return map[string]interface{}{
+		"id":            id,
+		"api_type":      apiType,
+		"creation_time": creationTime,
+		"deletion_time": deletionTime,
+	}
+}
@@ -0,0 +1,116 @@
+package cosmos
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func dataSourceArmCosmosDbSQLClientEncryptionKey() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmCosmosDbSQLClientEncryptionKeyRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.CosmosEntityName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.CosmosAccountName,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.CosmosEntityName,
+			},
+
+			"encryption_algorithm": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"key_wrap_metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"algorithm": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmCosmosDbSQLClientEncryptionKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.SqlClientEncryptionKeyClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	account := d.Get("account_name").(string)
+	database := d.Get("database_name").(string)
+
+	resp, err := client.GetClientEncryptionKey(ctx, resourceGroup, account, database, name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			return fmt.Errorf("Cosmos Client Encryption Key %q (Account: %q, Database: %q) was not found", name, account, database)
+		}
+
+		return fmt.Errorf("reading Cosmos Client Encryption Key %q (Account: %q, Database: %q): %+v", name, account, database, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("reading Cosmos Client Encryption Key %q (Account: %q, Database: %q): ID was nil", name, account, database)
+	}
+
+	d.SetId(*resp.ID)
+
+	if props := resp.ClientEncryptionKeyGetProperties; props != nil {
+		if res := props.Resource; res != nil {
+			d.Set("encryption_algorithm", res.EncryptionAlgorithm)
+
+			if err := d.Set("key_wrap_metadata", flattenCosmosDbSQLClientEncryptionKeyWrapMetadata(res.KeyWrapMetadata)); err != nil {
+				return fmt.Errorf("setting %q: %+v", "key_wrap_metadata", err)
+			}
+		}
+	}
+
+	return nil
+}
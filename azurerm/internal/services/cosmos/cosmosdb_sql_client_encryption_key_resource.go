@@ -0,0 +1,333 @@
+package cosmos
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmCosmosDbSQLClientEncryptionKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmCosmosDbSQLClientEncryptionKeyCreate,
+		Read:   resourceArmCosmosDbSQLClientEncryptionKeyRead,
+		Update: resourceArmCosmosDbSQLClientEncryptionKeyUpdate,
+		Delete: resourceArmCosmosDbSQLClientEncryptionKeyDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosEntityName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"account_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosAccountName,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.CosmosEntityName,
+			},
+
+			"encryption_algorithm": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"AEAD_AES_256_CBC_HMAC_SHA256",
+				}, false),
+			},
+
+			"key_wrap_metadata": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"type": {
+							Type:     schema.TypeString,
+							Required: true,
+							ValidateFunc: validation.StringInSlice([]string{
+								"AzureKeyVault",
+							}, false),
+						},
+
+						"value": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"algorithm": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+
+			"wrapped_data_encryption_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func resourceArmCosmosDbSQLClientEncryptionKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.SqlClientEncryptionKeyClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	account := d.Get("account_name").(string)
+	database := d.Get("database_name").(string)
+
+	existing, err := client.GetClientEncryptionKey(ctx, resourceGroup, account, database, name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(existing.Response) {
+			return fmt.Errorf("checking for presence of creating Cosmos Client Encryption Key %q (Account: %q, Database: %q): %+v", name, account, database, err)
+		}
+	} else {
+		if existing.ID == nil {
+			return fmt.Errorf("generating import ID for Cosmos Client Encryption Key %q (Account: %q, Database: %q)", name, account, database)
+		}
+
+		return tf.ImportAsExistsError("azurerm_cosmosdb_sql_client_encryption_key", *existing.ID)
+	}
+
+	wrappedDek, err := expandCosmosDbSQLClientEncryptionKeyWrappedDek(d)
+	if err != nil {
+		return fmt.Errorf("generating wrapped data encryption key for Cosmos Client Encryption Key %q (Account: %q, Database: %q): %+v", name, account, database, err)
+	}
+
+	key := documentdb.ClientEncryptionKeyCreateUpdateParameters{
+		ClientEncryptionKeyCreateUpdateProperties: &documentdb.ClientEncryptionKeyCreateUpdateProperties{
+			Resource: &documentdb.ClientEncryptionKeyResource{
+				ID:                       &name,
+				EncryptionAlgorithm:      utils.String(d.Get("encryption_algorithm").(string)),
+				WrappedDataEncryptionKey: utils.String(wrappedDek),
+				KeyWrapMetadata:          expandCosmosDbSQLClientEncryptionKeyWrapMetadata(d.Get("key_wrap_metadata").([]interface{})),
+			},
+		},
+	}
+
+	future, err := client.CreateUpdateClientEncryptionKey(ctx, resourceGroup, account, database, name, key)
+	if err != nil {
+		return fmt.Errorf("issuing create/update request for Cosmos Client Encryption Key %q (Account: %q, Database: %q): %+v", name, account, database, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on create/update future for Cosmos Client Encryption Key %q (Account: %q, Database: %q): %+v", name, account, database, err)
+	}
+
+	resp, err := client.GetClientEncryptionKey(ctx, resourceGroup, account, database, name)
+	if err != nil {
+		return fmt.Errorf("making get request for Cosmos Client Encryption Key %q (Account: %q, Database: %q): %+v", name, account, database, err)
+	}
+
+	if resp.ID == nil {
+		return fmt.Errorf("getting ID from Cosmos Client Encryption Key %q (Account: %q, Database: %q)", name, account, database)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmCosmosDbSQLClientEncryptionKeyRead(d, meta)
+}
+
+func resourceArmCosmosDbSQLClientEncryptionKeyUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.SqlClientEncryptionKeyClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SqlClientEncryptionKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// the CEK API has no dedicated PATCH - every update re-issues a CreateUpdate with the same CEK
+	// name, sending the configured `wrapped_data_encryption_key` and `key_wrap_metadata` as given (no
+	// wrapping happens here), so `encryption_algorithm` can't change but `key_wrap_metadata` can be rotated
+	wrappedDek, err := expandCosmosDbSQLClientEncryptionKeyWrappedDek(d)
+	if err != nil {
+		return fmt.Errorf("generating rewrapped data encryption key for Cosmos Client Encryption Key %q (Account: %q, Database: %q): %+v", id.Name, id.Account, id.Database, err)
+	}
+
+	key := documentdb.ClientEncryptionKeyCreateUpdateParameters{
+		ClientEncryptionKeyCreateUpdateProperties: &documentdb.ClientEncryptionKeyCreateUpdateProperties{
+			Resource: &documentdb.ClientEncryptionKeyResource{
+				ID:                       &id.Name,
+				EncryptionAlgorithm:      utils.String(d.Get("encryption_algorithm").(string)),
+				WrappedDataEncryptionKey: utils.String(wrappedDek),
+				KeyWrapMetadata:          expandCosmosDbSQLClientEncryptionKeyWrapMetadata(d.Get("key_wrap_metadata").([]interface{})),
+			},
+		},
+	}
+
+	future, err := client.CreateUpdateClientEncryptionKey(ctx, id.ResourceGroup, id.Account, id.Database, id.Name, key)
+	if err != nil {
+		return fmt.Errorf("issuing create/update request for Cosmos Client Encryption Key %q (Account: %q, Database: %q): %+v", id.Name, id.Account, id.Database, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on create/update future for Cosmos Client Encryption Key %q (Account: %q, Database: %q): %+v", id.Name, id.Account, id.Database, err)
+	}
+
+	return resourceArmCosmosDbSQLClientEncryptionKeyRead(d, meta)
+}
+
+func resourceArmCosmosDbSQLClientEncryptionKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.SqlClientEncryptionKeyClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SqlClientEncryptionKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetClientEncryptionKey(ctx, id.ResourceGroup, id.Account, id.Database, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Error reading Cosmos Client Encryption Key %q (Account: %q, Database: %q) - removing from state", id.Name, id.Account, id.Database)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("reading Cosmos Client Encryption Key %q (Account: %q, Database: %q): %+v", id.Name, id.Account, id.Database, err)
+	}
+
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("account_name", id.Account)
+	d.Set("database_name", id.Database)
+
+	if props := resp.ClientEncryptionKeyGetProperties; props != nil {
+		if res := props.Resource; res != nil {
+			d.Set("name", res.ID)
+			d.Set("encryption_algorithm", res.EncryptionAlgorithm)
+			d.Set("wrapped_data_encryption_key", res.WrappedDataEncryptionKey)
+
+			if err := d.Set("key_wrap_metadata", flattenCosmosDbSQLClientEncryptionKeyWrapMetadata(res.KeyWrapMetadata)); err != nil {
+				return fmt.Errorf("setting %q: %+v", "key_wrap_metadata", err)
+			}
+		}
+	}
+
+	return nil
+}
+
+// resourceArmCosmosDbSQLClientEncryptionKeyDelete is a no-op against the API: the Client Encryption
+// Key management API has no delete operation - once created, a CEK lives for the life of the
+// database (this is a deliberate Cosmos DB constraint, since documents may already be encrypted
+// with it). Deleting this resource only removes it from Terraform state; the CEK itself is left
+// behind in the database.
+func resourceArmCosmosDbSQLClientEncryptionKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	id, err := parse.SqlClientEncryptionKeyID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[INFO] Cosmos Client Encryption Keys cannot be deleted via the API - removing %q (Account: %q, Database: %q) from state", id.Name, id.Account, id.Database)
+
+	return nil
+}
+
+func expandCosmosDbSQLClientEncryptionKeyWrapMetadata(input []interface{}) *documentdb.KeyWrapMetadata {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	return &documentdb.KeyWrapMetadata{
+		Name:      utils.String(v["name"].(string)),
+		Type:      utils.String(v["type"].(string)),
+		Value:     utils.String(v["value"].(string)),
+		Algorithm: utils.String(v["algorithm"].(string)),
+	}
+}
+
+func flattenCosmosDbSQLClientEncryptionKeyWrapMetadata(input *documentdb.KeyWrapMetadata) []interface{} {
+	if input == nil {
+		return []interface{}{}
+	}
+
+	name := ""
+	if input.Name != nil {
+		name = *input.Name
+	}
+
+	typ := ""
+	if input.Type != nil {
+		typ = *input.Type
+	}
+
+	value := ""
+	if input.Value != nil {
+		value = *input.Value
+	}
+
+	algorithm := ""
+	if input.Algorithm != nil {
+		algorithm = *input.Algorithm
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name":      name,
+			"type":      typ,
+			"value":     value,
+			"algorithm": algorithm,
+		},
+	}
+}
+
+// expandCosmosDbSQLClientEncryptionKeyWrappedDek returns the base64 wrappedDataEncryptionKey
+// supplied by the user - wrapping the data encryption key is performed client-side against the
+// key referenced by `key_wrap_metadata` before it is ever passed to Terraform.
+func expandCosmosDbSQLClientEncryptionKeyWrappedDek(d *schema.ResourceData) (string, error) {
+	v, ok := d.GetOk("wrapped_data_encryption_key")
+	if !ok {
+		return "", fmt.Errorf("`wrapped_data_encryption_key` must be supplied")
+	}
+
+	return v.(string), nil
+}
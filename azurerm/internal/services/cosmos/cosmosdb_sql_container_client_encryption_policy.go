@@ -0,0 +1,138 @@
+package cosmos
+
+import (
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+// schemaCosmosDbSQLContainerClientEncryptionPolicy returns the `client_encryption_policy` block for
+// `azurerm_cosmosdb_sql_container` - it lets a container enable Always Encrypted by referencing one
+// or more `azurerm_cosmosdb_sql_client_encryption_key` resources. It is ForceNew as the encryption
+// policy can only be set when the container is created.
+//
+// NOTE: the `azurerm_cosmosdb_sql_container` resource itself is not part of this checkout, so this
+// block is not yet wired into a `Schema`/`Create`/`Read` - do that as part of whatever change brings
+// the container resource into this tree.
+func schemaCosmosDbSQLContainerClientEncryptionPolicy() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"included_path": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MinItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"path": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"client_encryption_key_id": {
+								Type:         schema.TypeString,
+								Required:     true,
+								ForceNew:     true,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+
+							"encryption_type": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"Deterministic",
+									"Randomized",
+								}, false),
+							},
+
+							"encryption_algorithm": {
+								Type:     schema.TypeString,
+								Required: true,
+								ForceNew: true,
+								ValidateFunc: validation.StringInSlice([]string{
+									"AEAD_AES_256_CBC_HMAC_SHA256",
+								}, false),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandCosmosDbSQLContainerClientEncryptionPolicy(input []interface{}) *documentdb.ClientEncryptionPolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	includedPathsRaw := v["included_path"].([]interface{})
+
+	includedPaths := make([]documentdb.ClientEncryptionIncludedPath, 0, len(includedPathsRaw))
+	for _, raw := range includedPathsRaw {
+		path := raw.(map[string]interface{})
+
+		includedPaths = append(includedPaths, documentdb.ClientEncryptionIncludedPath{
+			Path:                  utils.String(path["path"].(string)),
+			ClientEncryptionKeyID: utils.String(path["client_encryption_key_id"].(string)),
+			EncryptionType:        utils.String(path["encryption_type"].(string)),
+			EncryptionAlgorithm:   utils.String(path["encryption_algorithm"].(string)),
+		})
+	}
+
+	return &documentdb.ClientEncryptionPolicy{
+		IncludedPaths: &includedPaths,
+	}
+}
+
+func flattenCosmosDbSQLContainerClientEncryptionPolicy(input *documentdb.ClientEncryptionPolicy) []interface{} {
+	if input == nil || input.IncludedPaths == nil {
+		return []interface{}{}
+	}
+
+	includedPaths := make([]interface{}, 0, len(*input.IncludedPaths))
+	for _, path := range *input.IncludedPaths {
+		p := ""
+		if path.Path != nil {
+			p = *path.Path
+		}
+
+		keyID := ""
+		if path.ClientEncryptionKeyID != nil {
+			keyID = *path.ClientEncryptionKeyID
+		}
+
+		encryptionType := ""
+		if path.EncryptionType != nil {
+			encryptionType = *path.EncryptionType
+		}
+
+		encryptionAlgorithm := ""
+		if path.EncryptionAlgorithm != nil {
+			encryptionAlgorithm = *path.EncryptionAlgorithm
+		}
+
+		includedPaths = append(includedPaths, map[string]interface{}{
+			"path":                     p,
+			"client_encryption_key_id": keyID,
+			"encryption_type":          encryptionType,
+			"encryption_algorithm":     encryptionAlgorithm,
+		})
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"included_path": includedPaths,
+		},
+	}
+}
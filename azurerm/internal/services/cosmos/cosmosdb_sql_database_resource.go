@@ -5,9 +5,11 @@ import (
 	"log"
 	"time"
 
-	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2020-04-01/documentdb"
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/Azure/go-autorest/autorest/date"
 	"github.com/hashicorp/go-azure-helpers/response"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/validate"
@@ -71,6 +73,53 @@ func resourceArmCosmosDbSQLDatabase() *schema.Resource {
 			},
 
 			"autoscale_settings": common.DatabaseAutoscaleSettingsSchema(),
+
+			"restore": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_cosmosdb_account_id": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: azure.ValidateResourceID,
+						},
+
+						"restore_timestamp_in_utc": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IsRFC3339Time,
+						},
+
+						"databases_to_restore": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"database_name": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validate.CosmosEntityName,
+									},
+
+									"collection_names": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -116,6 +165,16 @@ func resourceArmCosmosDbSQLDatabaseCreate(d *schema.ResourceData, meta interface
 		db.SQLDatabaseCreateUpdateProperties.Options.AutoscaleSettings = common.ExpandCosmosDbAutoscaleSettings(d)
 	}
 
+	if restore, hasRestore := d.GetOk("restore"); hasRestore {
+		restoreParameters, err := expandCosmosDbSQLDatabaseRestoreParameters(restore.([]interface{}))
+		if err != nil {
+			return fmt.Errorf("Error expanding `restore` for Cosmos SQL Database %q (Account: %q): %+v", name, account, err)
+		}
+
+		db.SQLDatabaseCreateUpdateProperties.Resource.CreateMode = documentdb.CreateModeRestore
+		db.SQLDatabaseCreateUpdateProperties.Resource.RestoreParameters = restoreParameters
+	}
+
 	future, err := client.CreateUpdateSQLDatabase(ctx, resourceGroup, account, name, db)
 	if err != nil {
 		return fmt.Errorf("Error issuing create/update request for Cosmos SQL Database %q (Account: %q): %+v", name, account, err)
@@ -258,3 +317,46 @@ func resourceArmCosmosDbSQLDatabaseDelete(d *schema.ResourceData, meta interface
 
 	return nil
 }
+
+func expandCosmosDbSQLDatabaseRestoreParameters(input []interface{}) (*documentdb.RestoreParameters, error) {
+	if len(input) == 0 || input[0] == nil {
+		return nil, nil
+	}
+
+	v := input[0].(map[string]interface{})
+
+	ts, err := time.Parse(time.RFC3339, v["restore_timestamp_in_utc"].(string))
+	if err != nil {
+		return nil, fmt.Errorf("parsing `restore_timestamp_in_utc` %q: %+v", v["restore_timestamp_in_utc"].(string), err)
+	}
+
+	params := &documentdb.RestoreParameters{
+		RestoreMode:           documentdb.PointInTime,
+		RestoreSource:         utils.String(v["source_cosmosdb_account_id"].(string)),
+		RestoreTimestampInUtc: &date.Time{Time: ts},
+		DatabasesToRestore:    expandCosmosDbSQLDatabasesToRestore(v["databases_to_restore"].([]interface{})),
+	}
+
+	return params, nil
+}
+
+func expandCosmosDbSQLDatabasesToRestore(input []interface{}) *[]documentdb.DatabaseRestoreResource {
+	out := make([]documentdb.DatabaseRestoreResource, 0, len(input))
+
+	for _, raw := range input {
+		v := raw.(map[string]interface{})
+
+		collectionNamesRaw := v["collection_names"].([]interface{})
+		collectionNames := make([]string, 0, len(collectionNamesRaw))
+		for _, c := range collectionNamesRaw {
+			collectionNames = append(collectionNames, c.(string))
+		}
+
+		out = append(out, documentdb.DatabaseRestoreResource{
+			DatabaseName:    utils.String(v["database_name"].(string)),
+			CollectionNames: &collectionNames,
+		})
+	}
+
+	return &out
+}
@@ -0,0 +1,116 @@
+package cosmos
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/cosmos-db/mgmt/2021-10-15/documentdb"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+func dataSourceArmCosmosDbSQLRestorableDatabase() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmCosmosDbSQLRestorableDatabaseRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"restorable_database_account_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"databases": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"owner_resource_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"owner_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"can_undelete": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"event_timestamp": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmCosmosDbSQLRestorableDatabaseRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Cosmos.SqlRestorableDatabaseClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountID := d.Get("restorable_database_account_id").(string)
+
+	resp, err := client.List(ctx, accountID)
+	if err != nil {
+		return fmt.Errorf("listing restorable SQL Databases for account %q: %+v", accountID, err)
+	}
+
+	databases := make([]interface{}, 0)
+	values := resp.Values()
+	for _, db := range values {
+		databases = append(databases, flattenCosmosDbSQLRestorableDatabase(db))
+	}
+
+	d.SetId(fmt.Sprintf("%s/restorableSqlDatabases", accountID))
+	d.Set("databases", databases)
+
+	return nil
+}
+
+func flattenCosmosDbSQLRestorableDatabase(input documentdb.RestorableSQLDatabaseGetResult) map[string]interface{} {
+	ownerResourceID := ""
+	ownerID := ""
+	canUndelete := ""
+	eventTimestamp := ""
+
+	if props := input.RestorableSQLDatabaseProperties; props != nil {
+		if res := props.Resource; res != nil {
+			if res.OwnerResourceID != nil {
+				ownerResourceID = *res.OwnerResourceID
+			}
+
+			if res.OwnerID != nil {
+				ownerID = *res.OwnerID
+			}
+
+			if res.CanUndelete != nil {
+				canUndelete = *res.CanUndelete
+			}
+
+			if res.EventTimestamp != nil {
+				eventTimestamp = *res.EventTimestamp
+			}
+		}
+	}
+
+	return map[string]interface{}{
+		"owner_resource_id": ownerResourceID,
+		"owner_id":          ownerID,
+		"can_undelete":      canUndelete,
+		"event_timestamp":   eventTimestamp,
+	}
+}
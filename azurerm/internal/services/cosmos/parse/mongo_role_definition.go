@@ -0,0 +1,76 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type MongoRoleDefinitionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Account        string
+	Database       string
+	RoleName       string
+}
+
+func NewMongoRoleDefinitionID(subscriptionId, resourceGroup, account, database, roleName string) MongoRoleDefinitionId {
+	return MongoRoleDefinitionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Account:        account,
+		Database:       database,
+		RoleName:       roleName,
+	}
+}
+
+// RoleDefinitionId returns the identifier the Mongo RBAC API uses to address this role definition -
+// and the name of the `mongodbRoleDefinitions` segment in the resource's ARM ID.
+func (id MongoRoleDefinitionId) RoleDefinitionId() string {
+	return fmt.Sprintf("%s.%s", id.Database, id.RoleName)
+}
+
+// ID returns the full ARM resource ID for this role definition, used to round-trip through
+// state/import.
+func (id MongoRoleDefinitionId) ID() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/mongodbDatabases/%s/mongodbRoleDefinitions/%s",
+		id.SubscriptionId, id.ResourceGroup, id.Account, id.Database, id.RoleDefinitionId())
+}
+
+func MongoRoleDefinitionID(input string) (*MongoRoleDefinitionId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Cosmos Mongo Role Definition ID %q: %+v", input, err)
+	}
+
+	roleDefinition := MongoRoleDefinitionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if roleDefinition.Account, err = id.PopSegment("databaseAccounts"); err != nil {
+		return nil, err
+	}
+
+	if roleDefinition.Database, err = id.PopSegment("mongodbDatabases"); err != nil {
+		return nil, err
+	}
+
+	roleDefinitionId, err := id.PopSegment("mongodbRoleDefinitions")
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := fmt.Sprintf("%s.", roleDefinition.Database)
+	if !strings.HasPrefix(roleDefinitionId, prefix) {
+		return nil, fmt.Errorf("parsing Cosmos Mongo Role Definition ID %q: `mongodbRoleDefinitions` segment %q was not prefixed with database name %q", input, roleDefinitionId, roleDefinition.Database)
+	}
+	roleDefinition.RoleName = strings.TrimPrefix(roleDefinitionId, prefix)
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &roleDefinition, nil
+}
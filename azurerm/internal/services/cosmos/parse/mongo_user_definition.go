@@ -0,0 +1,76 @@
+package parse
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type MongoUserDefinitionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	Account        string
+	Database       string
+	Username       string
+}
+
+func NewMongoUserDefinitionID(subscriptionId, resourceGroup, account, database, username string) MongoUserDefinitionId {
+	return MongoUserDefinitionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		Account:        account,
+		Database:       database,
+		Username:       username,
+	}
+}
+
+// UserDefinitionId returns the identifier the Mongo RBAC API uses to address this user definition -
+// and the name of the `mongodbUserDefinitions` segment in the resource's ARM ID.
+func (id MongoUserDefinitionId) UserDefinitionId() string {
+	return fmt.Sprintf("%s.%s", id.Database, id.Username)
+}
+
+// ID returns the full ARM resource ID for this user definition, used to round-trip through
+// state/import.
+func (id MongoUserDefinitionId) ID() string {
+	return fmt.Sprintf("/subscriptions/%s/resourceGroups/%s/providers/Microsoft.DocumentDB/databaseAccounts/%s/mongodbDatabases/%s/mongodbUserDefinitions/%s",
+		id.SubscriptionId, id.ResourceGroup, id.Account, id.Database, id.UserDefinitionId())
+}
+
+func MongoUserDefinitionID(input string) (*MongoUserDefinitionId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Cosmos Mongo User Definition ID %q: %+v", input, err)
+	}
+
+	userDefinition := MongoUserDefinitionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if userDefinition.Account, err = id.PopSegment("databaseAccounts"); err != nil {
+		return nil, err
+	}
+
+	if userDefinition.Database, err = id.PopSegment("mongodbDatabases"); err != nil {
+		return nil, err
+	}
+
+	userDefinitionId, err := id.PopSegment("mongodbUserDefinitions")
+	if err != nil {
+		return nil, err
+	}
+
+	prefix := fmt.Sprintf("%s.", userDefinition.Database)
+	if !strings.HasPrefix(userDefinitionId, prefix) {
+		return nil, fmt.Errorf("parsing Cosmos Mongo User Definition ID %q: `mongodbUserDefinitions` segment %q was not prefixed with database name %q", input, userDefinitionId, userDefinition.Database)
+	}
+	userDefinition.Username = strings.TrimPrefix(userDefinitionId, prefix)
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &userDefinition, nil
+}
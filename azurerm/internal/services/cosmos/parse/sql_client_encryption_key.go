@@ -0,0 +1,43 @@
+package parse
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type SqlClientEncryptionKeyId struct {
+	ResourceGroup string
+	Account       string
+	Database      string
+	Name          string
+}
+
+func SqlClientEncryptionKeyID(input string) (*SqlClientEncryptionKeyId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Cosmos Client Encryption Key ID %q: %+v", input, err)
+	}
+
+	key := SqlClientEncryptionKeyId{
+		ResourceGroup: id.ResourceGroup,
+	}
+
+	if key.Account, err = id.PopSegment("databaseAccounts"); err != nil {
+		return nil, err
+	}
+
+	if key.Database, err = id.PopSegment("sqlDatabases"); err != nil {
+		return nil, err
+	}
+
+	if key.Name, err = id.PopSegment("clientEncryptionKeys"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
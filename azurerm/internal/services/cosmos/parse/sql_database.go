@@ -0,0 +1,38 @@
+package parse
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type SqlDatabaseId struct {
+	ResourceGroup string
+	Account       string
+	Name          string
+}
+
+func SqlDatabaseID(input string) (*SqlDatabaseId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Cosmos SQL Database ID %q: %+v", input, err)
+	}
+
+	database := SqlDatabaseId{
+		ResourceGroup: id.ResourceGroup,
+	}
+
+	if database.Account, err = id.PopSegment("databaseAccounts"); err != nil {
+		return nil, err
+	}
+
+	if database.Name, err = id.PopSegment("sqlDatabases"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &database, nil
+}
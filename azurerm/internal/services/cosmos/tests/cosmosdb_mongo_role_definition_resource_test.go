@@ -0,0 +1,241 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMCosmosDbMongoRoleDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_mongo_role_definition", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbMongoRoleDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDbMongoRoleDefinition_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDbMongoRoleDefinitionExists(data.ResourceName),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDbMongoRoleDefinition_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_mongo_role_definition", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbMongoRoleDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDbMongoRoleDefinition_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDbMongoRoleDefinitionExists(data.ResourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMCosmosDbMongoRoleDefinition_requiresImport(data),
+				ExpectError: acceptance.RequiresImportError(data.ResourceType),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDbMongoRoleDefinition_disappears(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_mongo_role_definition", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbMongoRoleDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDbMongoRoleDefinition_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDbMongoRoleDefinitionExists(data.ResourceName),
+					testCheckAzureRMCosmosDbMongoRoleDefinitionDisappears(data.ResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMCosmosDbMongoRoleDefinitionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Cosmos.MongoDbRoleDefinitionClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parse.MongoRoleDefinitionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetMongoRoleDefinition(ctx, id.RoleDefinitionId(), id.ResourceGroup, id.Account)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Cosmos Mongo Role Definition %q (Account: %q, Database: %q) was not found", id.RoleName, id.Account, id.Database)
+			}
+
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMCosmosDbMongoRoleDefinitionDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Cosmos.MongoDbRoleDefinitionClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_cosmosdb_mongo_role_definition" {
+			continue
+		}
+
+		id, err := parse.MongoRoleDefinitionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetMongoRoleDefinition(ctx, id.RoleDefinitionId(), id.ResourceGroup, id.Account)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Cosmos Mongo Role Definition %q (Account: %q, Database: %q) still exists: %+v", id.RoleName, id.Account, id.Database, resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMCosmosDbMongoRoleDefinitionDisappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Cosmos.MongoDbRoleDefinitionClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parse.MongoRoleDefinitionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		future, err := client.DeleteMongoRoleDefinition(ctx, id.RoleDefinitionId(), id.ResourceGroup, id.Account)
+		if err != nil {
+			return err
+		}
+
+		return future.WaitForCompletionRef(ctx, client.Client)
+	}
+}
+
+func testAccAzureRMCosmosDbMongoRoleDefinition_basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_mongo_role_definition" "test" {
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  database_name       = azurerm_cosmosdb_mongo_database.test.name
+  role_name           = "acctest-role-%[2]d"
+
+  privilege {
+    resource {
+      db = azurerm_cosmosdb_mongo_database.test.name
+    }
+
+    actions = ["find", "insert"]
+  }
+}
+`, testAccAzureRMCosmosDBAccount_mongoRBAC(data), data.RandomInteger)
+}
+
+func testAccAzureRMCosmosDbMongoRoleDefinition_requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_mongo_role_definition" "import" {
+  resource_group_name = azurerm_cosmosdb_mongo_role_definition.test.resource_group_name
+  account_name        = azurerm_cosmosdb_mongo_role_definition.test.account_name
+  database_name       = azurerm_cosmosdb_mongo_role_definition.test.database_name
+  role_name           = azurerm_cosmosdb_mongo_role_definition.test.role_name
+
+  privilege {
+    resource {
+      db = azurerm_cosmosdb_mongo_database.test.name
+    }
+
+    actions = ["find", "insert"]
+  }
+}
+`, testAccAzureRMCosmosDbMongoRoleDefinition_basic(data))
+}
+
+// testAccAzureRMCosmosDBAccount_mongoRBAC is the shared base config for a Mongo API Cosmos DB
+// account with Mongo RBAC enabled, reused by acceptance tests for the Mongo role/user definition
+// resources which both require the `EnableMongoRoleBasedAccessControl` capability.
+func testAccAzureRMCosmosDBAccount_mongoRBAC(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cosmos-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                = "acctest-ca-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  offer_type          = "Standard"
+  kind                = "MongoDB"
+
+  capabilities {
+    name = "EnableMongo"
+  }
+
+  capabilities {
+    name = "EnableMongoRoleBasedAccessControl"
+  }
+
+  consistency_policy {
+    consistency_level = "Session"
+  }
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = 0
+  }
+}
+
+resource "azurerm_cosmosdb_mongo_database" "test" {
+  name                = "acctest-mongodb-%[1]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
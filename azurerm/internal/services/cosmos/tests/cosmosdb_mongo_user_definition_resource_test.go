@@ -0,0 +1,194 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMCosmosDbMongoUserDefinition_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_mongo_user_definition", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbMongoUserDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDbMongoUserDefinition_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDbMongoUserDefinitionExists(data.ResourceName),
+				),
+			},
+			data.ImportStep("password"),
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDbMongoUserDefinition_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_mongo_user_definition", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbMongoUserDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDbMongoUserDefinition_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDbMongoUserDefinitionExists(data.ResourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMCosmosDbMongoUserDefinition_requiresImport(data),
+				ExpectError: acceptance.RequiresImportError(data.ResourceType),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDbMongoUserDefinition_disappears(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_mongo_user_definition", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbMongoUserDefinitionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDbMongoUserDefinition_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDbMongoUserDefinitionExists(data.ResourceName),
+					testCheckAzureRMCosmosDbMongoUserDefinitionDisappears(data.ResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMCosmosDbMongoUserDefinitionExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Cosmos.MongoDbUserDefinitionClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parse.MongoUserDefinitionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetMongoUserDefinition(ctx, id.UserDefinitionId(), id.ResourceGroup, id.Account)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Cosmos Mongo User Definition %q (Account: %q, Database: %q) was not found", id.Username, id.Account, id.Database)
+			}
+
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMCosmosDbMongoUserDefinitionDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Cosmos.MongoDbUserDefinitionClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_cosmosdb_mongo_user_definition" {
+			continue
+		}
+
+		id, err := parse.MongoUserDefinitionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetMongoUserDefinition(ctx, id.UserDefinitionId(), id.ResourceGroup, id.Account)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Cosmos Mongo User Definition %q (Account: %q, Database: %q) still exists: %+v", id.Username, id.Account, id.Database, resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMCosmosDbMongoUserDefinitionDisappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Cosmos.MongoDbUserDefinitionClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parse.MongoUserDefinitionID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		future, err := client.DeleteMongoUserDefinition(ctx, id.UserDefinitionId(), id.ResourceGroup, id.Account)
+		if err != nil {
+			return err
+		}
+
+		return future.WaitForCompletionRef(ctx, client.Client)
+	}
+}
+
+func testAccAzureRMCosmosDbMongoUserDefinition_basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_mongo_user_definition" "test" {
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  database_name       = azurerm_cosmosdb_mongo_database.test.name
+  username            = "acctest-user-%[2]d"
+  password            = "NotARealP@ssw0rd123!"
+
+  roles {
+    db   = azurerm_cosmosdb_mongo_database.test.name
+    role = "readWrite"
+  }
+}
+`, testAccAzureRMCosmosDBAccount_mongoRBAC(data), data.RandomInteger)
+}
+
+func testAccAzureRMCosmosDbMongoUserDefinition_requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_mongo_user_definition" "import" {
+  resource_group_name = azurerm_cosmosdb_mongo_user_definition.test.resource_group_name
+  account_name        = azurerm_cosmosdb_mongo_user_definition.test.account_name
+  database_name       = azurerm_cosmosdb_mongo_user_definition.test.database_name
+  username            = azurerm_cosmosdb_mongo_user_definition.test.username
+  password            = azurerm_cosmosdb_mongo_user_definition.test.password
+
+  roles {
+    db   = azurerm_cosmosdb_mongo_database.test.name
+    role = "readWrite"
+  }
+}
+`, testAccAzureRMCosmosDbMongoUserDefinition_basic(data))
+}
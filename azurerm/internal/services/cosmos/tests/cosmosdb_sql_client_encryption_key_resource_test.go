@@ -0,0 +1,241 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/cosmos/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMCosmosDbSQLClientEncryptionKey_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_client_encryption_key", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbSQLClientEncryptionKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDbSQLClientEncryptionKey_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDbSQLClientEncryptionKeyExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "encryption_algorithm", "AEAD_AES_256_CBC_HMAC_SHA256"),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccAzureRMCosmosDbSQLClientEncryptionKey_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_client_encryption_key", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbSQLClientEncryptionKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDbSQLClientEncryptionKey_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDbSQLClientEncryptionKeyExists(data.ResourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMCosmosDbSQLClientEncryptionKey_requiresImport(data),
+				ExpectError: acceptance.RequiresImportError(data.ResourceType),
+			},
+		},
+	})
+}
+
+// TestAccAzureRMCosmosDbSQLClientEncryptionKey_disappears forces removal via the underlying SQL
+// database rather than the key itself - the Client Encryption Key API exposes no delete operation,
+// so deleting the database it lives in is the only way to make it disappear out-of-band.
+func TestAccAzureRMCosmosDbSQLClientEncryptionKey_disappears(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cosmosdb_sql_client_encryption_key", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMCosmosDbSQLClientEncryptionKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMCosmosDbSQLClientEncryptionKey_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMCosmosDbSQLClientEncryptionKeyExists(data.ResourceName),
+					testCheckAzureRMCosmosDbSQLDatabaseDisappearsForCEK("azurerm_cosmosdb_sql_database.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testCheckAzureRMCosmosDbSQLDatabaseDisappearsForCEK deletes the SQL database the Client
+// Encryption Key lives in - the key resource's own Delete is a no-op, so its only disappears
+// signal is the parent database going away underneath it.
+func testCheckAzureRMCosmosDbSQLDatabaseDisappearsForCEK(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Cosmos.SqlClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		account := rs.Primary.Attributes["account_name"]
+		name := rs.Primary.Attributes["name"]
+
+		future, err := client.DeleteSQLDatabase(ctx, resourceGroup, account, name)
+		if err != nil {
+			return err
+		}
+
+		return future.WaitForCompletionRef(ctx, client.Client)
+	}
+}
+
+func testCheckAzureRMCosmosDbSQLClientEncryptionKeyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).Cosmos.SqlClientEncryptionKeyClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parse.SqlClientEncryptionKeyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetClientEncryptionKey(ctx, id.ResourceGroup, id.Account, id.Database, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("Cosmos Client Encryption Key %q (Account: %q, Database: %q) was not found", id.Name, id.Account, id.Database)
+			}
+
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMCosmosDbSQLClientEncryptionKeyDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).Cosmos.SqlClientEncryptionKeyClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_cosmosdb_sql_client_encryption_key" {
+			continue
+		}
+
+		id, err := parse.SqlClientEncryptionKeyID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.GetClientEncryptionKey(ctx, id.ResourceGroup, id.Account, id.Database, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("Cosmos Client Encryption Key %q (Account: %q, Database: %q) still exists: %+v", id.Name, id.Account, id.Database, resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMCosmosDbSQLClientEncryptionKey_basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_sql_database" "test" {
+  name                = "acctest-db-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+}
+
+resource "azurerm_cosmosdb_sql_client_encryption_key" "test" {
+  name                = "acctest-cek-%[2]d"
+  resource_group_name = azurerm_cosmosdb_account.test.resource_group_name
+  account_name        = azurerm_cosmosdb_account.test.name
+  database_name       = azurerm_cosmosdb_sql_database.test.name
+
+  encryption_algorithm        = "AEAD_AES_256_CBC_HMAC_SHA256"
+  wrapped_data_encryption_key = "d2VhcmVub3RyZWFsbHlhd3JhcHBlZGtleQ=="
+
+  key_wrap_metadata {
+    name      = "acctestkey-%[2]d"
+    type      = "AzureKeyVault"
+    value     = "https://acctestkv-%[2]d.vault.azure.net/keys/acctestkey/01234567890123456789012345678901"
+    algorithm = "RSA-OAEP"
+  }
+}
+`, testAccAzureRMCosmosDBAccount_clientEncryptionKey(data), data.RandomInteger)
+}
+
+func testAccAzureRMCosmosDbSQLClientEncryptionKey_requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cosmosdb_sql_client_encryption_key" "import" {
+  name                        = azurerm_cosmosdb_sql_client_encryption_key.test.name
+  resource_group_name         = azurerm_cosmosdb_sql_client_encryption_key.test.resource_group_name
+  account_name                = azurerm_cosmosdb_sql_client_encryption_key.test.account_name
+  database_name               = azurerm_cosmosdb_sql_client_encryption_key.test.database_name
+  encryption_algorithm        = azurerm_cosmosdb_sql_client_encryption_key.test.encryption_algorithm
+  wrapped_data_encryption_key = azurerm_cosmosdb_sql_client_encryption_key.test.wrapped_data_encryption_key
+
+  key_wrap_metadata {
+    name      = "acctestkey-%d"
+    type      = "AzureKeyVault"
+    value     = "https://acctestkv-%[2]d.vault.azure.net/keys/acctestkey/01234567890123456789012345678901"
+    algorithm = "RSA-OAEP"
+  }
+}
+`, testAccAzureRMCosmosDbSQLClientEncryptionKey_basic(data), data.RandomInteger)
+}
+
+// testAccAzureRMCosmosDBAccount_clientEncryptionKey is the shared base config for a SQL API Cosmos DB account,
+// reused by acceptance tests for resources that hang off an `azurerm_cosmosdb_account`.
+func testAccAzureRMCosmosDBAccount_clientEncryptionKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-cosmos-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_cosmosdb_account" "test" {
+  name                = "acctest-ca-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  offer_type          = "Standard"
+  kind                = "GlobalDocumentDB"
+
+  consistency_policy {
+    consistency_level = "Session"
+  }
+
+  geo_location {
+    location          = azurerm_resource_group.test.location
+    failover_priority = 0
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
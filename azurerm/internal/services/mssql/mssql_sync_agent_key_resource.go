@@ -0,0 +1,97 @@
+package mssql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+// resourceArmMsSqlSyncAgentKey wraps the SyncAgentsClient.GenerateKey action. The Sync Agent
+// management API has no notion of reading back a previously generated key - every apply that
+// reaches Create/Update mints a fresh one. Regenerating this resource does not taint or replace
+// any azurerm_mssql_sync_member relying on the on-prem agent having registered with it.
+func resourceArmMsSqlSyncAgentKey() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlSyncAgentKeyCreateUpdate,
+		Read:   resourceArmMsSqlSyncAgentKeyRead,
+		Update: resourceArmMsSqlSyncAgentKeyCreateUpdate,
+		Delete: resourceArmMsSqlSyncAgentKeyDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"sync_agent_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"key": {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func resourceArmMsSqlSyncAgentKeyCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.SyncAgentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	syncAgentID := d.Get("sync_agent_id").(string)
+
+	id, err := parse.SyncAgentID(syncAgentID)
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GenerateKey(ctx, resourceGroup, serverName, id.Name)
+	if err != nil {
+		return fmt.Errorf("generating key for SQL Sync Agent %q (Resource Group %q, Server %q): %+v", id.Name, resourceGroup, serverName, err)
+	}
+
+	if resp.SyncAgentKey == nil {
+		return fmt.Errorf("generating key for SQL Sync Agent %q (Resource Group %q, Server %q): key returned was nil", id.Name, resourceGroup, serverName)
+	}
+
+	d.SetId(syncAgentID)
+	d.Set("key", resp.SyncAgentKey)
+
+	return resourceArmMsSqlSyncAgentKeyRead(d, meta)
+}
+
+func resourceArmMsSqlSyncAgentKeyRead(d *schema.ResourceData, meta interface{}) error {
+	// the key itself is only ever returned by GenerateKey - there is nothing further to read back
+	// from the API, so the value computed on create/update is left untouched here.
+	return nil
+}
+
+func resourceArmMsSqlSyncAgentKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	// Sync Agent keys cannot be revoked independently of the Sync Agent itself - removing this
+	// resource from state does not invalidate the key previously handed to the on-prem agent.
+	return nil
+}
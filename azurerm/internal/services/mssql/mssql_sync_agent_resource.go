@@ -0,0 +1,192 @@
+package mssql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMsSqlSyncAgent() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlSyncAgentCreateUpdate,
+		Read:   resourceArmMsSqlSyncAgentRead,
+		Update: resourceArmMsSqlSyncAgentCreateUpdate,
+		Delete: resourceArmMsSqlSyncAgentDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"sync_database_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateResourceID,
+			},
+
+			"state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"is_up_to_date": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+
+			"expiry_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmMsSqlSyncAgentCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.SyncAgentsClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	syncDatabaseID := d.Get("sync_database_id").(string)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, serverName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing SQL Sync Agent %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mssql_sync_agent", *existing.ID)
+		}
+	}
+
+	properties := sql.SyncAgent{
+		SyncAgentProperties: &sql.SyncAgentProperties{
+			SyncDatabaseID: utils.String(syncDatabaseID),
+		},
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, name, properties)
+	if err != nil {
+		return fmt.Errorf("creating/updating SQL Sync Agent %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on create/update operation for SQL Sync Agent %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, name)
+	if err != nil {
+		return fmt.Errorf("retrieving SQL Sync Agent %q (Resource Group %q, Server %q): %+v", name, resourceGroup, serverName, err)
+	}
+
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("reading ID for SQL Sync Agent %q (Resource Group %q, Server %q)", name, resourceGroup, serverName)
+	}
+
+	d.SetId(*resp.ID)
+
+	return resourceArmMsSqlSyncAgentRead(d, meta)
+}
+
+func resourceArmMsSqlSyncAgentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.SyncAgentsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SyncAgentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Error reading SQL Sync Agent %q - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("reading SQL Sync Agent %q (Resource Group %q, Server %q): %+v", id.Name, id.ResourceGroup, id.ServerName, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("server_name", id.ServerName)
+
+	if props := resp.SyncAgentProperties; props != nil {
+		d.Set("sync_database_id", props.SyncDatabaseID)
+		d.Set("state", string(props.State))
+		d.Set("is_up_to_date", props.IsUpToDate)
+		d.Set("version", props.Version)
+
+		if props.ExpiryTime != nil {
+			d.Set("expiry_time", props.ExpiryTime.String())
+		}
+	}
+
+	return nil
+}
+
+func resourceArmMsSqlSyncAgentDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.SyncAgentsClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SyncAgentID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ServerName, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting SQL Sync Agent %q (Resource Group %q, Server %q): %+v", id.Name, id.ResourceGroup, id.ServerName, err)
+	}
+
+	return future.WaitForCompletionRef(ctx, client.Client)
+}
@@ -2,14 +2,16 @@ package mssql
 
 import (
 	"fmt"
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sql/parse"
 	"log"
 
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/sql/parse"
+
 	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/validate"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
@@ -82,6 +84,12 @@ func resourceArmMsSqlSyncGroup() *schema.Resource {
 				Optional: true,
 			},
 
+			"refresh_schema_on_apply": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
 			"table": {
 				Type:     schema.TypeList,
 				Required: true,
@@ -121,6 +129,44 @@ func resourceArmMsSqlSyncGroup() *schema.Resource {
 					},
 				},
 			},
+
+			// the schema Data Sync actually discovered on the hub database the last time it was
+			// refreshed - compare against `table` to see drift, without clobbering the configured value
+			"hub_schema_table": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"column": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"data_size": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+
+									"data_type": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -138,18 +184,18 @@ func resourceArmMsSqlSyncGroupCreateUpdate(d *schema.ResourceData, meta interfac
 	hubDbUsername := d.Get("hub_database_username").(string)
 	hubDbPassword := d.Get("hub_database_password").(string)
 
-	//if d.IsNewResource() {
-	//	existing, err := client.Get(ctx, resourceGroup, serverName, databaseName, name)
-	//	if err != nil {
-	//		if !utils.ResponseWasNotFound(existing.Response) {
-	//			return fmt.Errorf("checking for presence of existing SQL Sync Group %q (Resource Group %q, Server %q, Database %q): %+v", name, resourceGroup, serverName, databaseName, err)
-	//		}
-	//	}
-	//
-	//	if existing.ID != nil && *existing.ID != "" {
-	//		return tf.ImportAsExistsError("azurerm_sql_sync_group", *existing.ID)
-	//	}
-	//}
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, serverName, databaseName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing SQL Sync Group %q (Resource Group %q, Server %q, Database %q): %+v", name, resourceGroup, serverName, databaseName, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mssql_sync_group", *existing.ID)
+		}
+	}
 
 	properties := sql.SyncGroup{
 		SyncGroupProperties: &sql.SyncGroupProperties{
@@ -158,7 +204,7 @@ func resourceArmMsSqlSyncGroupCreateUpdate(d *schema.ResourceData, meta interfac
 			HubDatabasePassword:      utils.String(hubDbPassword),
 			SyncDatabaseID:           utils.String(syncDatabaseId),
 			Schema: &sql.SyncGroupSchema{
-				Tables: expandMsSqlSyncGroupSchemaTables(d.Get("tables").([]interface{})),
+				Tables: expandMsSqlSyncGroupSchemaTables(d.Get("table").([]interface{})),
 			},
 		},
 	}
@@ -180,6 +226,17 @@ func resourceArmMsSqlSyncGroupCreateUpdate(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("waiting on create/update operation for SQL Sync Group %q (Resource Group %q, Server %q, Database: %q): %+v", name, resourceGroup, serverName, databaseName, err)
 	}
 
+	if d.Get("refresh_schema_on_apply").(bool) {
+		refreshFuture, err := client.RefreshHubSchema(ctx, resourceGroup, serverName, databaseName, name)
+		if err != nil {
+			return fmt.Errorf("refreshing hub schema for SQL Sync Group %q (Resource Group %q, Server %q, Database: %q): %+v", name, resourceGroup, serverName, databaseName, err)
+		}
+
+		if err = refreshFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on hub schema refresh for SQL Sync Group %q (Resource Group %q, Server %q, Database: %q): %+v", name, resourceGroup, serverName, databaseName, err)
+		}
+	}
+
 	return resourceArmMsSqlSyncGroupRead(d, meta)
 }
 
@@ -252,6 +309,21 @@ func resourceArmMsSqlSyncGroupRead(d *schema.ResourceData, meta interface{}) err
 		}
 	}
 
+	// ListHubSchemas reflects what Data Sync discovered on the hub database at its last schema
+	// refresh, which can drift from the `table`/`column` blocks configured here - it is recorded in
+	// `hub_schema_table` and `table` is left as returned by the Sync Group. `hub_schema_table` is
+	// computed only, so a difference between the two does not by itself produce a `terraform plan` diff.
+	if d.Get("refresh_schema_on_apply").(bool) {
+		hubSchema, err := client.ListHubSchemas(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName, id.Name)
+		if err != nil {
+			return fmt.Errorf("listing hub schema for SQL Sync Group %q (Resource Group %q, Server %q, Database: %q): %+v", id.Name, id.ResourceGroup, id.ServerName, id.DatabaseName, err)
+		}
+
+		if err = d.Set("hub_schema_table", flattenMsSqlSyncGroupHubSchemaTables(hubSchema.Values())); err != nil {
+			return fmt.Errorf("setting %q", "hub_schema_table")
+		}
+	}
+
 	return nil
 }
 
@@ -327,13 +399,64 @@ func flattenMsSqlSyncGroupSchemaTableColumns(columns *[]sql.SyncGroupSchemaTable
 	return out
 }
 
+func flattenMsSqlSyncGroupHubSchemaTables(tables []sql.SyncFullSchemaProperties) []interface{} {
+	out := make([]interface{}, 0, len(tables))
+
+	for _, table := range tables {
+		name := ""
+		if table.Name != nil {
+			name = *table.Name
+		}
+
+		out = append(out, map[string]interface{}{
+			"name":   name,
+			"column": flattenMsSqlSyncGroupHubSchemaColumns(table.Columns),
+		})
+	}
+
+	return out
+}
+
+func flattenMsSqlSyncGroupHubSchemaColumns(columns *[]sql.SyncFullSchemaTableColumn) []interface{} {
+	if columns == nil {
+		return []interface{}{}
+	}
+
+	out := make([]interface{}, 0, len(*columns))
+
+	for _, column := range *columns {
+		name := ""
+		if column.Name != nil {
+			name = *column.Name
+		}
+
+		dataSize := ""
+		if column.DataSize != nil {
+			dataSize = *column.DataSize
+		}
+
+		dataType := ""
+		if column.DataType != nil {
+			dataType = *column.DataType
+		}
+
+		out = append(out, map[string]interface{}{
+			"name":      name,
+			"data_size": dataSize,
+			"data_type": dataType,
+		})
+	}
+
+	return out
+}
+
 func expandMsSqlSyncGroupSchemaTables(tables []interface{}) *[]sql.SyncGroupSchemaTable {
 	out := make([]sql.SyncGroupSchemaTable, 0, len(tables))
 
 	for _, tableRaw := range tables {
 		table := tableRaw.(map[string]interface{})
 		outTable := sql.SyncGroupSchemaTable{
-			Columns:    expandMsSqlSyncGroupSchemaTableColumns(table["columns"].([]interface{})),
+			Columns:    expandMsSqlSyncGroupSchemaTableColumns(table["column"].([]interface{})),
 			QuotedName: utils.String(table["name"].(string)),
 		}
 		out = append(out, outTable)
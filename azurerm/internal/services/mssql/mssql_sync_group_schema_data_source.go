@@ -0,0 +1,95 @@
+package mssql
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+)
+
+// dataSourceArmMsSqlSyncGroupSchema discovers the hub database's actual schema via
+// SyncGroupsClient.ListHubSchemas, so the `table`/`column` blocks an azurerm_mssql_sync_group
+// needs can be bootstrapped with `for_each` instead of hand-listed column by column.
+func dataSourceArmMsSqlSyncGroupSchema() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceArmMsSqlSyncGroupSchemaRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"sync_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateMsSqlSyncGroupName,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupNameForDataSource(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: azure.ValidateMsSqlDatabaseName,
+			},
+
+			"table": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+
+						"column": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name":      {Type: schema.TypeString, Computed: true},
+									"data_size": {Type: schema.TypeString, Computed: true},
+									"data_type": {Type: schema.TypeString, Computed: true},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceArmMsSqlSyncGroupSchemaRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.SyncGroupsClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	databaseName := d.Get("database_name").(string)
+	syncGroupName := d.Get("sync_group_name").(string)
+
+	hubSchema, err := client.ListHubSchemas(ctx, resourceGroup, serverName, databaseName, syncGroupName)
+	if err != nil {
+		return fmt.Errorf("listing hub schema for SQL Sync Group %q (Resource Group %q, Server %q, Database: %q): %+v", syncGroupName, resourceGroup, serverName, databaseName, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/syncGroups/%s/hubSchemas", databaseName, syncGroupName))
+
+	if err := d.Set("table", flattenMsSqlSyncGroupHubSchemaTables(hubSchema.Values())); err != nil {
+		return fmt.Errorf("setting %q: %+v", "table", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,296 @@
+package mssql
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/v3.0/sql"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceArmMsSqlSyncMember() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceArmMsSqlSyncMemberCreateUpdate,
+		Read:   resourceArmMsSqlSyncMemberRead,
+		Update: resourceArmMsSqlSyncMemberCreateUpdate,
+		Delete: resourceArmMsSqlSyncMemberDelete,
+
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Read:   schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"resource_group_name": azure.SchemaResourceGroupName(),
+
+			"server_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlServerName,
+			},
+
+			"database_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlDatabaseName,
+			},
+
+			"sync_group_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azure.ValidateMsSqlSyncGroupName,
+			},
+
+			"member_database_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.AzureSqlDatabase),
+					string(sql.SQLServerDatabase),
+				}, false),
+			},
+
+			"sync_direction": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(sql.Bidirectional),
+					string(sql.OneWayMemberToHub),
+					string(sql.OneWayHubToMember),
+				}, false),
+			},
+
+			"sync_agent_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			// the ARM resource ID of the member database - a UUID for a `SqlServerDatabase` (on-prem, via
+			// sync agent) member, or the full `azurerm_mssql_database` resource ID for an `AzureSqlDatabase` member
+			"sql_server_database_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"member_server_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"member_database_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"member_username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"member_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			// whether to refresh the member's schema against the hub at apply time, surfacing any
+			// incompatibilities immediately rather than waiting for the next scheduled sync to fail -
+			// defaults to true, but can be disabled to skip the refresh LRO on every apply
+			"refresh_schema_on_apply": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Default:  true,
+			},
+
+			"sync_state": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceArmMsSqlSyncMemberCreateUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.SyncMembersClient
+	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	name := d.Get("name").(string)
+	resourceGroup := d.Get("resource_group_name").(string)
+	serverName := d.Get("server_name").(string)
+	databaseName := d.Get("database_name").(string)
+	syncGroupName := d.Get("sync_group_name").(string)
+
+	if d.IsNewResource() {
+		existing, err := client.Get(ctx, resourceGroup, serverName, databaseName, syncGroupName, name)
+		if err != nil {
+			if !utils.ResponseWasNotFound(existing.Response) {
+				return fmt.Errorf("checking for presence of existing SQL Sync Member %q (Resource Group %q, Server %q, Database %q, Sync Group %q): %+v", name, resourceGroup, serverName, databaseName, syncGroupName, err)
+			}
+		}
+
+		if existing.ID != nil && *existing.ID != "" {
+			return tf.ImportAsExistsError("azurerm_mssql_sync_member", *existing.ID)
+		}
+	}
+
+	memberDatabaseType := d.Get("member_database_type").(string)
+	syncAgentID := d.Get("sync_agent_id").(string)
+	if memberDatabaseType == string(sql.SQLServerDatabase) && syncAgentID == "" {
+		return fmt.Errorf("`sync_agent_id` must be set when `member_database_type` is %q", sql.SQLServerDatabase)
+	}
+
+	properties := sql.SyncMember{
+		SyncMemberProperties: &sql.SyncMemberProperties{
+			DatabaseType:  sql.SyncMemberDbType(memberDatabaseType),
+			SyncDirection: sql.SyncDirection(d.Get("sync_direction").(string)),
+		},
+	}
+
+	if syncAgentID != "" {
+		properties.SyncMemberProperties.SyncAgentID = utils.String(syncAgentID)
+	}
+
+	if v, ok := d.GetOk("sql_server_database_id"); ok {
+		properties.SyncMemberProperties.SQLServerDatabaseID = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("member_server_name"); ok {
+		properties.SyncMemberProperties.ServerName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("member_database_name"); ok {
+		properties.SyncMemberProperties.DatabaseName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("member_username"); ok {
+		properties.SyncMemberProperties.UserName = utils.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("member_password"); ok {
+		properties.SyncMemberProperties.Password = utils.String(v.(string))
+	}
+
+	future, err := client.CreateOrUpdate(ctx, resourceGroup, serverName, databaseName, syncGroupName, name, properties)
+	if err != nil {
+		return fmt.Errorf("creating/updating SQL Sync Member %q (Resource Group %q, Server %q, Database %q, Sync Group %q): %+v", name, resourceGroup, serverName, databaseName, syncGroupName, err)
+	}
+
+	if err = future.WaitForCompletionRef(ctx, client.Client); err != nil {
+		return fmt.Errorf("waiting on create/update operation for SQL Sync Member %q (Resource Group %q, Server %q, Database %q, Sync Group %q): %+v", name, resourceGroup, serverName, databaseName, syncGroupName, err)
+	}
+
+	resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, syncGroupName, name)
+	if err != nil {
+		return fmt.Errorf("retrieving SQL Sync Member %q (Resource Group %q, Server %q, Database %q, Sync Group %q): %+v", name, resourceGroup, serverName, databaseName, syncGroupName, err)
+	}
+
+	if resp.ID == nil || *resp.ID == "" {
+		return fmt.Errorf("reading ID for SQL Sync Member %q (Resource Group %q, Server %q, Database %q, Sync Group %q)", name, resourceGroup, serverName, databaseName, syncGroupName)
+	}
+
+	d.SetId(*resp.ID)
+
+	if d.Get("refresh_schema_on_apply").(bool) {
+		refreshFuture, err := client.RefreshMemberSchema(ctx, resourceGroup, serverName, databaseName, syncGroupName, name)
+		if err != nil {
+			return fmt.Errorf("refreshing schema for SQL Sync Member %q (Resource Group %q, Server %q, Database %q, Sync Group %q): %+v", name, resourceGroup, serverName, databaseName, syncGroupName, err)
+		}
+
+		if err = refreshFuture.WaitForCompletionRef(ctx, client.Client); err != nil {
+			return fmt.Errorf("waiting on schema refresh operation for SQL Sync Member %q (Resource Group %q, Server %q, Database %q, Sync Group %q): %+v", name, resourceGroup, serverName, databaseName, syncGroupName, err)
+		}
+	}
+
+	return resourceArmMsSqlSyncMemberRead(d, meta)
+}
+
+func resourceArmMsSqlSyncMemberRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.SyncMembersClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SyncMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName, id.SyncGroupName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp.Response) {
+			log.Printf("[INFO] Error reading SQL Sync Member %q - removing from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("reading SQL Sync Member %q (Resource Group %q, Server %q, Database %q, Sync Group %q): %+v", id.Name, id.ResourceGroup, id.ServerName, id.DatabaseName, id.SyncGroupName, err)
+	}
+
+	d.Set("name", id.Name)
+	d.Set("resource_group_name", id.ResourceGroup)
+	d.Set("server_name", id.ServerName)
+	d.Set("database_name", id.DatabaseName)
+	d.Set("sync_group_name", id.SyncGroupName)
+
+	if props := resp.SyncMemberProperties; props != nil {
+		d.Set("member_database_type", string(props.DatabaseType))
+		d.Set("sync_direction", string(props.SyncDirection))
+		d.Set("sync_agent_id", props.SyncAgentID)
+		d.Set("sql_server_database_id", props.SQLServerDatabaseID)
+		d.Set("member_server_name", props.ServerName)
+		d.Set("member_database_name", props.DatabaseName)
+		d.Set("member_username", props.UserName)
+		d.Set("sync_state", string(props.SyncState))
+	}
+
+	return nil
+}
+
+func resourceArmMsSqlSyncMemberDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).MSSQL.SyncMembersClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.SyncMemberID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	future, err := client.Delete(ctx, id.ResourceGroup, id.ServerName, id.DatabaseName, id.SyncGroupName, id.Name)
+	if err != nil {
+		return fmt.Errorf("deleting SQL Sync Member %q (Resource Group %q, Server %q, Database %q, Sync Group %q): %+v", id.Name, id.ResourceGroup, id.ServerName, id.DatabaseName, id.SyncGroupName, err)
+	}
+
+	return future.WaitForCompletionRef(ctx, client.Client)
+}
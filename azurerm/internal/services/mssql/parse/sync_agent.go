@@ -0,0 +1,38 @@
+package parse
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type SyncAgentId struct {
+	ResourceGroup string
+	ServerName    string
+	Name          string
+}
+
+func SyncAgentID(input string) (*SyncAgentId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Sync Agent ID %q: %+v", input, err)
+	}
+
+	agent := SyncAgentId{
+		ResourceGroup: id.ResourceGroup,
+	}
+
+	if agent.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, err
+	}
+
+	if agent.Name, err = id.PopSegment("syncAgents"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &agent, nil
+}
@@ -0,0 +1,48 @@
+package parse
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type SyncMemberId struct {
+	ResourceGroup string
+	ServerName    string
+	DatabaseName  string
+	SyncGroupName string
+	Name          string
+}
+
+func SyncMemberID(input string) (*SyncMemberId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Sync Member ID %q: %+v", input, err)
+	}
+
+	member := SyncMemberId{
+		ResourceGroup: id.ResourceGroup,
+	}
+
+	if member.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, err
+	}
+
+	if member.DatabaseName, err = id.PopSegment("databases"); err != nil {
+		return nil, err
+	}
+
+	if member.SyncGroupName, err = id.PopSegment("syncGroups"); err != nil {
+		return nil, err
+	}
+
+	if member.Name, err = id.PopSegment("syncMembers"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &member, nil
+}
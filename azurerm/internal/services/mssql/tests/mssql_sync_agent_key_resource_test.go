@@ -0,0 +1,128 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/mssql/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMsSqlSyncAgentKey_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_sync_agent_key", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncAgentKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlSyncAgentKey_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncAgentKeyExists(data.ResourceName),
+					resource.TestCheckResourceAttrSet(data.ResourceName, "key"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlSyncAgentKey_disappears(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_sync_agent_key", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncAgentKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlSyncAgentKey_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncAgentKeyExists(data.ResourceName),
+					testCheckAzureRMMsSqlSyncAgentDisappears("azurerm_mssql_sync_agent.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testCheckAzureRMMsSqlSyncAgentKeyExists confirms the Sync Agent the key was generated for is
+// still present - the key itself is never returned back from the API once generated, so the
+// underlying Sync Agent's existence is the only thing this resource can verify.
+func testCheckAzureRMMsSqlSyncAgentKeyExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).MSSQL.SyncAgentsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		id, err := parse.SyncAgentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("SQL Sync Agent %q (server %q / resource group %q) was not found", id.Name, id.ServerName, id.ResourceGroup)
+			}
+
+			return err
+		}
+
+		return nil
+	}
+}
+
+// testCheckAzureRMMsSqlSyncAgentKeyDestroy asserts the underlying Sync Agent no longer exists once
+// the rest of the config has been torn down - the key resource has no Delete API call of its own,
+// so this is the only observable signal that the resource is gone.
+func testCheckAzureRMMsSqlSyncAgentKeyDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).MSSQL.SyncAgentsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_sync_agent_key" {
+			continue
+		}
+
+		id, err := parse.SyncAgentID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := client.Get(ctx, id.ResourceGroup, id.ServerName, id.Name)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("SQL Sync Agent %q (server %q / resource group %q) still exists: %+v", id.Name, id.ServerName, id.ResourceGroup, resp)
+	}
+
+	return nil
+}
+
+func testAccAzureRMMsSqlSyncAgentKey_basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_sync_agent_key" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  server_name         = azurerm_mssql_server.test.name
+  sync_agent_id       = azurerm_mssql_sync_agent.test.id
+}
+`, testAccAzureRMMsSqlSyncAgent_basic(data))
+}
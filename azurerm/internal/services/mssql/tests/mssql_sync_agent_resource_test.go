@@ -0,0 +1,187 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMsSqlSyncAgent_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_sync_agent", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncAgentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlSyncAgent_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncAgentExists(data.ResourceName),
+				),
+			},
+			data.ImportStep(),
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlSyncAgent_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_sync_agent", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncAgentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlSyncAgent_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncAgentExists(data.ResourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMsSqlSyncAgent_requiresImport(data),
+				ExpectError: acceptance.RequiresImportError(data.ResourceType),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlSyncAgent_disappears(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_sync_agent", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncAgentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlSyncAgent_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncAgentExists(data.ResourceName),
+					testCheckAzureRMMsSqlSyncAgentDisappears(data.ResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMsSqlSyncAgentExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).MSSQL.SyncAgentsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		syncAgentName := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, syncAgentName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("SQL Sync Agent %q (server %q / resource group %q) was not found", syncAgentName, serverName, resourceGroup)
+			}
+
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlSyncAgentDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).MSSQL.SyncAgentsClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_sync_agent" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		syncAgentName := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, syncAgentName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("SQL Sync Agent %q (server %q / resource group %q) still exists: %+v", syncAgentName, serverName, resourceGroup, resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMMsSqlSyncAgentDisappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).MSSQL.SyncAgentsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		syncAgentName := rs.Primary.Attributes["name"]
+
+		future, err := client.Delete(ctx, resourceGroup, serverName, syncAgentName)
+		if err != nil {
+			return err
+		}
+
+		return future.WaitForCompletionRef(ctx, client.Client)
+	}
+}
+
+func testAccAzureRMMsSqlSyncAgent_basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database" "sync_metadata" {
+  name                             = "syncMetadata%d"
+  resource_group_name              = azurerm_resource_group.test.name
+  server_name                      = azurerm_mssql_server.test.name
+  location                         = azurerm_resource_group.test.location
+  edition                          = "Standard"
+  requested_service_objective_name = "S1"
+}
+
+resource "azurerm_mssql_sync_agent" "test" {
+  name                = "acctest-syncagent-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  server_name         = azurerm_mssql_server.test.name
+  sync_database_id    = azurerm_mssql_database.sync_metadata.id
+}
+`, testAccAzureRMMsSqlServer_basic(data), data.RandomInteger)
+}
+
+func testAccAzureRMMsSqlSyncAgent_requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_sync_agent" "import" {
+  name                = azurerm_mssql_sync_agent.test.name
+  resource_group_name = azurerm_mssql_sync_agent.test.resource_group_name
+  server_name         = azurerm_mssql_sync_agent.test.server_name
+  sync_database_id    = azurerm_mssql_sync_agent.test.sync_database_id
+}
+`, testAccAzureRMMsSqlSyncAgent_basic(data))
+}
@@ -1,10 +1,12 @@
 package tests
 
 import (
+	"database/sql"
 	"fmt"
 	"testing"
 
-	"github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-03-01-preview/sql"
+	sqlmgmt "github.com/Azure/azure-sdk-for-go/services/preview/sql/mgmt/2017-03-01-preview/sql"
+	_ "github.com/denisenkom/go-mssqldb"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 
@@ -61,6 +63,47 @@ func TestAccAzureRMMsSqlSyncGroup_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMMsSqlSyncGroup_schemaDrift(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_sync_group", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlServer_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlServerExists("azurerm_mssql_server.test"),
+				),
+			},
+			{
+				// apply with `refresh_schema_on_apply` already set - this is the exact config the
+				// next, plan-only step reuses unchanged, so that step's non-empty plan can only be
+				// caused by the out-of-band mutation below, not by a config attribute changing
+				PreConfig: testSetupAzureRMMsSqlSyncGroup(data),
+				Config:    testAccAzureRMMsSqlSyncGroup_refreshSchemaOnApply(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlDatabaseExists("azurerm_mssql_database.test"),
+					testCheckAzureRMMsSqlSyncGroupExists(data.ResourceName),
+					// mutate the hub schema out-of-band by dropping a synced column via a raw SQL
+					// connection, so the next step's non-empty plan is actually caused by the new
+					// drift-detection Read logic rather than by a config-only attribute change
+					testMutateAzureRMMsSqlSyncGroupHubSchema(data, "azurerm_mssql_server.test"),
+				),
+			},
+			{
+				// the config is unchanged from the step above - any diff here can only come from
+				// `ListHubSchemas`/`flattenMsSqlSyncGroupHubSchemaTables` picking up the dropped
+				// [Color] column, proving the drift-detection Read logic is what's under test
+				Config:             testAccAzureRMMsSqlSyncGroup_refreshSchemaOnApply(data),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
 func TestAccAzureRMMsSqlSyncGroup_disappears(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_database", "test")
 
@@ -95,12 +138,12 @@ func testSetupAzureRMMsSqlSyncGroup(data acceptance.TestData) func() {
 		serverName        := fmt.Sprintf("acctestsqlserver%d", data.RandomInteger)
 		databaseName      := fmt.Sprintf("syncHub%d", data.RandomInteger)
 
-		properties := sql.Database{
-			DatabaseProperties: &sql.DatabaseProperties{
-				CreateMode: sql.CreateModeDefault,
-				Edition:    sql.Standard,
+		properties := sqlmgmt.Database{
+			DatabaseProperties: &sqlmgmt.DatabaseProperties{
+				CreateMode: sqlmgmt.CreateModeDefault,
+				Edition:    sqlmgmt.Standard,
 				//MaxSizeBytes:                  nil,
-				RequestedServiceObjectiveName: sql.ServiceObjectiveNameS2,
+				RequestedServiceObjectiveName: sqlmgmt.ServiceObjectiveNameS2,
 				SampleName:                    "AdventureWorksLT",
 				ZoneRedundant:                 utils.Bool(false),
 			},
@@ -115,6 +158,38 @@ func testSetupAzureRMMsSqlSyncGroup(data acceptance.TestData) func() {
 	}
 }
 
+// testMutateAzureRMMsSqlSyncGroupHubSchema connects directly to the hub database with a raw SQL
+// connection and drops a column that's part of the sync group's `table`/`column` blocks - this is
+// the out-of-band mutation the drift-detection Read logic needs to notice, as opposed to merely
+// calling the same RefreshHubSchema API the resource itself already calls on every apply.
+func testMutateAzureRMMsSqlSyncGroupHubSchema(data acceptance.TestData, serverResourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[serverResourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", serverResourceName)
+		}
+
+		fqdn := rs.Primary.Attributes["fully_qualified_domain_name"]
+		login := rs.Primary.Attributes["administrator_login"]
+		password := rs.Primary.Attributes["administrator_login_password"]
+		databaseName := fmt.Sprintf("syncHub%d", data.RandomInteger)
+
+		connString := fmt.Sprintf("server=%s;user id=%s;password=%s;database=%s;encrypt=true", fqdn, login, password, databaseName)
+
+		db, err := sql.Open("sqlserver", connString)
+		if err != nil {
+			return fmt.Errorf("connecting to hub database %q: %+v", databaseName, err)
+		}
+		defer db.Close()
+
+		if _, err := db.Exec("ALTER TABLE [SalesLT].[Product] DROP COLUMN [Color]"); err != nil {
+			return fmt.Errorf("dropping [Color] column from hub database %q: %+v", databaseName, err)
+		}
+
+		return nil
+	}
+}
+
 func testCheckAzureRMMsSqlSyncGroupExists(resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := acceptance.AzureProvider.Meta().(*clients.Client).Sql.SyncGroupsClient
@@ -252,6 +327,58 @@ resource "azurerm_mssql_sync_group" "test" {
 `, testAccAzureRMMsSqlServer_basic(data), data.RandomInteger)
 }
 
+func testAccAzureRMMsSqlSyncGroup_refreshSchemaOnApply(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database" "sync" {
+  name                             = "syncStore%d"
+  resource_group_name              = azurerm_resource_group.test.name
+  server_name                      = azurerm_mssql_server.test.name
+  location                         = azurerm_resource_group.test.location
+  edition                          = "Standard"
+  requested_service_objective_name = "S1"
+}
+
+resource "azurerm_mssql_sync_group" "test" {
+  name                = "acctest-syncgroup-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  server_name         = azurerm_mssql_server.test.name
+  database_name       = "syncHub%[2]d"
+
+  conflict_resolution_policy = "HubWin"
+  sync_database_id           = azurerm_mssql_database.sync.id
+
+  hub_database_username = azurerm_mssql_server.test.administrator_login
+  hub_database_password = "thisIsDog11"
+
+  refresh_schema_on_apply = true
+
+  table {
+    name = "[SalesLT].[Product]"
+
+    column {
+      name      = "[ProductID]"
+      data_size = "4"
+      data_type = "int"
+    }
+
+    column {
+      name      = "[ProductNumber]"
+      data_size = "25"
+      data_type = "nvarchar"
+    }
+
+    column {
+      name      = "[Color]"
+      data_size = "15"
+      data_type = "nvarchar"
+    }
+  }
+}
+`, testAccAzureRMMsSqlServer_basic(data), data.RandomInteger)
+}
+
 func testAccAzureRMMsSqlSyncGroup_requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -0,0 +1,350 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func TestAccAzureRMMsSqlSyncMember_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_sync_member", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlSyncMember_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncMemberExists(data.ResourceName),
+					resource.TestCheckResourceAttr(data.ResourceName, "sync_direction", "Bidirectional"),
+				),
+			},
+			data.ImportStep("member_password", "refresh_schema_on_apply"),
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlSyncMember_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_sync_member", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlSyncMember_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncMemberExists(data.ResourceName),
+				),
+			},
+			{
+				Config:      testAccAzureRMMsSqlSyncMember_requiresImport(data),
+				ExpectError: acceptance.RequiresImportError(data.ResourceType),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlSyncMember_bidirectionalConverges(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_sync_member", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlSyncMember_bidirectionalPair(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncMemberExists(data.ResourceName),
+					testCheckAzureRMMsSqlSyncMemberExists("azurerm_mssql_sync_member.second"),
+					resource.TestCheckResourceAttr(data.ResourceName, "sync_direction", "Bidirectional"),
+					resource.TestCheckResourceAttr("azurerm_mssql_sync_member.second", "sync_direction", "Bidirectional"),
+					testCheckAzureRMMsSqlSyncGroupTriggersSync("azurerm_mssql_sync_group.test"),
+					testCheckAzureRMMsSqlSyncMemberReachesSyncState(data.ResourceName, "Good"),
+					testCheckAzureRMMsSqlSyncMemberReachesSyncState("azurerm_mssql_sync_member.second", "Good"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccAzureRMMsSqlSyncMember_disappears(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_sync_member", "test")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:     func() { acceptance.PreCheck(t) },
+		Providers:    acceptance.SupportedProviders,
+		CheckDestroy: testCheckAzureRMMsSqlSyncMemberDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAzureRMMsSqlSyncMember_basic(data),
+				Check: resource.ComposeTestCheckFunc(
+					testCheckAzureRMMsSqlSyncMemberExists(data.ResourceName),
+					testCheckAzureRMMsSqlSyncMemberDisappears(data.ResourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testCheckAzureRMMsSqlSyncMemberExists(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).MSSQL.SyncMembersClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+		syncGroupName := rs.Primary.Attributes["sync_group_name"]
+		syncMemberName := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, syncGroupName, syncMemberName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return fmt.Errorf("SQL Sync Member %q (sync group %q / database %q / server %q / resource group %q) was not found", syncMemberName, syncGroupName, databaseName, serverName, resourceGroup)
+			}
+
+			return err
+		}
+
+		return nil
+	}
+}
+
+func testCheckAzureRMMsSqlSyncMemberDestroy(s *terraform.State) error {
+	client := acceptance.AzureProvider.Meta().(*clients.Client).MSSQL.SyncMembersClient
+	ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "azurerm_mssql_sync_member" {
+			continue
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+		syncGroupName := rs.Primary.Attributes["sync_group_name"]
+		syncMemberName := rs.Primary.Attributes["name"]
+
+		resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, syncGroupName, syncMemberName)
+		if err != nil {
+			if utils.ResponseWasNotFound(resp.Response) {
+				return nil
+			}
+
+			return err
+		}
+
+		return fmt.Errorf("SQL Sync Member %q (sync group %q / database %q / server %q / resource group %q) still exists: %+v", syncMemberName, syncGroupName, databaseName, serverName, resourceGroup, resp)
+	}
+
+	return nil
+}
+
+func testCheckAzureRMMsSqlSyncMemberDisappears(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).MSSQL.SyncMembersClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+		syncGroupName := rs.Primary.Attributes["sync_group_name"]
+		syncMemberName := rs.Primary.Attributes["name"]
+
+		future, err := client.Delete(ctx, resourceGroup, serverName, databaseName, syncGroupName, syncMemberName)
+		if err != nil {
+			return err
+		}
+
+		return future.WaitForCompletionRef(ctx, client.Client)
+	}
+}
+
+// testCheckAzureRMMsSqlSyncGroupTriggersSync kicks off an out-of-schedule sync so the two members'
+// `sync_state` has something to converge from instead of waiting on the sync group's `interval`.
+func testCheckAzureRMMsSqlSyncGroupTriggersSync(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).MSSQL.SyncGroupsClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+		syncGroupName := rs.Primary.Attributes["name"]
+
+		_, err := client.TriggerSync(ctx, resourceGroup, serverName, databaseName, syncGroupName)
+		return err
+	}
+}
+
+// testCheckAzureRMMsSqlSyncMemberReachesSyncState polls the sync member until its `sync_state`
+// reaches the expected value (or the timeout elapses), proving the two members configured
+// `Bidirectional` against the same sync group actually converge rather than just accepting the
+// static config value.
+func testCheckAzureRMMsSqlSyncMemberReachesSyncState(resourceName string, expected string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := acceptance.AzureProvider.Meta().(*clients.Client).MSSQL.SyncMembersClient
+		ctx := acceptance.AzureProvider.Meta().(*clients.Client).StopContext
+
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		resourceGroup := rs.Primary.Attributes["resource_group_name"]
+		serverName := rs.Primary.Attributes["server_name"]
+		databaseName := rs.Primary.Attributes["database_name"]
+		syncGroupName := rs.Primary.Attributes["sync_group_name"]
+		syncMemberName := rs.Primary.Attributes["name"]
+
+		return resource.RetryContext(ctx, 15*time.Minute, func() *resource.RetryError {
+			resp, err := client.Get(ctx, resourceGroup, serverName, databaseName, syncGroupName, syncMemberName)
+			if err != nil {
+				return resource.NonRetryableError(err)
+			}
+
+			if resp.SyncMemberProperties == nil {
+				return resource.RetryableError(fmt.Errorf("SQL Sync Member %q (sync group %q) has not reported a sync state yet", syncMemberName, syncGroupName))
+			}
+
+			if state := string(resp.SyncMemberProperties.SyncState); state != expected {
+				return resource.RetryableError(fmt.Errorf("SQL Sync Member %q (sync group %q) has sync_state %q, waiting for %q", syncMemberName, syncGroupName, state, expected))
+			}
+
+			return nil
+		})
+	}
+}
+
+func testAccAzureRMMsSqlSyncMember_basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database" "member" {
+  name                             = "syncMember%d"
+  resource_group_name              = azurerm_resource_group.test.name
+  server_name                      = azurerm_mssql_server.test.name
+  location                         = azurerm_resource_group.test.location
+  edition                          = "Standard"
+  requested_service_objective_name = "S1"
+}
+
+resource "azurerm_mssql_sync_member" "test" {
+  name                   = "acctest-syncmember-%[2]d"
+  resource_group_name    = azurerm_resource_group.test.name
+  server_name            = azurerm_mssql_server.test.name
+  database_name          = "syncHub%[2]d"
+  sync_group_name        = azurerm_mssql_sync_group.test.name
+  member_database_type   = "AzureSqlDatabase"
+  sync_direction         = "Bidirectional"
+  sql_server_database_id = azurerm_mssql_database.member.id
+  member_server_name     = azurerm_mssql_server.test.fully_qualified_domain_name
+  member_database_name   = azurerm_mssql_database.member.name
+  member_username        = azurerm_mssql_server.test.administrator_login
+  member_password        = "thisIsDog11"
+}
+`, testAccAzureRMMsSqlSyncGroup_basic(data), data.RandomInteger)
+}
+
+func testAccAzureRMMsSqlSyncMember_bidirectionalPair(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_database" "member" {
+  name                             = "syncMember%d"
+  resource_group_name              = azurerm_resource_group.test.name
+  server_name                      = azurerm_mssql_server.test.name
+  location                         = azurerm_resource_group.test.location
+  edition                          = "Standard"
+  requested_service_objective_name = "S1"
+}
+
+resource "azurerm_mssql_database" "second_member" {
+  name                             = "syncMember%[2]dSecond"
+  resource_group_name              = azurerm_resource_group.test.name
+  server_name                      = azurerm_mssql_server.test.name
+  location                         = azurerm_resource_group.test.location
+  edition                          = "Standard"
+  requested_service_objective_name = "S1"
+}
+
+resource "azurerm_mssql_sync_member" "test" {
+  name                   = "acctest-syncmember-%[2]d"
+  resource_group_name    = azurerm_resource_group.test.name
+  server_name            = azurerm_mssql_server.test.name
+  database_name          = "syncHub%[2]d"
+  sync_group_name        = azurerm_mssql_sync_group.test.name
+  member_database_type   = "AzureSqlDatabase"
+  sync_direction         = "Bidirectional"
+  sql_server_database_id = azurerm_mssql_database.member.id
+  member_server_name     = azurerm_mssql_server.test.fully_qualified_domain_name
+  member_database_name   = azurerm_mssql_database.member.name
+  member_username        = azurerm_mssql_server.test.administrator_login
+  member_password        = "thisIsDog11"
+}
+
+resource "azurerm_mssql_sync_member" "second" {
+  name                   = "acctest-syncmember-%[2]d-second"
+  resource_group_name    = azurerm_resource_group.test.name
+  server_name            = azurerm_mssql_server.test.name
+  database_name          = "syncHub%[2]d"
+  sync_group_name        = azurerm_mssql_sync_group.test.name
+  member_database_type   = "AzureSqlDatabase"
+  sync_direction         = "Bidirectional"
+  sql_server_database_id = azurerm_mssql_database.second_member.id
+  member_server_name     = azurerm_mssql_server.test.fully_qualified_domain_name
+  member_database_name   = azurerm_mssql_database.second_member.name
+  member_username        = azurerm_mssql_server.test.administrator_login
+  member_password        = "thisIsDog11"
+}
+`, testAccAzureRMMsSqlSyncGroup_basic(data), data.RandomInteger)
+}
+
+func testAccAzureRMMsSqlSyncMember_requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_sync_member" "import" {
+  name                   = azurerm_mssql_sync_member.test.name
+  resource_group_name    = azurerm_mssql_sync_member.test.resource_group_name
+  server_name            = azurerm_mssql_sync_member.test.server_name
+  database_name          = azurerm_mssql_sync_member.test.database_name
+  sync_group_name        = azurerm_mssql_sync_member.test.sync_group_name
+  member_database_type   = azurerm_mssql_sync_member.test.member_database_type
+  sync_direction         = azurerm_mssql_sync_member.test.sync_direction
+  sql_server_database_id = azurerm_mssql_sync_member.test.sql_server_database_id
+  member_server_name     = azurerm_mssql_sync_member.test.member_server_name
+  member_database_name   = azurerm_mssql_sync_member.test.member_database_name
+  member_username        = azurerm_mssql_sync_member.test.member_username
+  member_password        = azurerm_mssql_sync_member.test.member_password
+}
+`, testAccAzureRMMsSqlSyncMember_basic(data))
+}
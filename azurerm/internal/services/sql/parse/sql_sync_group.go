@@ -0,0 +1,43 @@
+package parse
+
+import (
+	"fmt"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type SqlSyncGroupId struct {
+	ResourceGroup string
+	ServerName    string
+	DatabaseName  string
+	Name          string
+}
+
+func SqlSyncGroupID(input string) (*SqlSyncGroupId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing Sql Sync Group ID %q: %+v", input, err)
+	}
+
+	syncGroup := SqlSyncGroupId{
+		ResourceGroup: id.ResourceGroup,
+	}
+
+	if syncGroup.ServerName, err = id.PopSegment("servers"); err != nil {
+		return nil, err
+	}
+
+	if syncGroup.DatabaseName, err = id.PopSegment("databases"); err != nil {
+		return nil, err
+	}
+
+	if syncGroup.Name, err = id.PopSegment("syncGroups"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &syncGroup, nil
+}